@@ -0,0 +1,33 @@
+package array
+
+import O "github.com/eicc27/Gophunc/optional"
+
+// ArgMin gets the index of the smallest element according to less,
+// in a single pass. If several elements are the smallest,
+// the first one wins. It returns a nothing optional if the array is empty.
+//
+// Example:
+//
+//	i := array.New(3, 1, 2, 1).ArgMin(func(a, b int) bool { return a < b })
+//	fmt.Println(i.Value()) // 1
+func (r *TypedArray[T, U]) ArgMin(less func(T, T) bool) *O.Optional[int] {
+	if len(r.array) == 0 {
+		return O.Nothing[int]()
+	}
+	best := 0
+	for i, v := range r.array[1:] {
+		if less(v, r.array[best]) {
+			best = i + 1
+		}
+	}
+	return O.Just(best)
+}
+
+// ArgMax gets the index of the largest element according to less,
+// in a single pass. If several elements are the largest,
+// the first one wins. It returns a nothing optional if the array is empty.
+func (r *TypedArray[T, U]) ArgMax(less func(T, T) bool) *O.Optional[int] {
+	return r.ArgMin(func(a, b T) bool {
+		return less(b, a)
+	})
+}
@@ -2,7 +2,9 @@ package array
 
 import (
 	"errors"
+	"slices"
 
+	"github.com/eicc27/Gophunc/internal/clone"
 	O "github.com/eicc27/Gophunc/optional"
 	R "github.com/eicc27/Gophunc/result"
 )
@@ -12,6 +14,15 @@ import (
 // For Map and FlatMap having a different type of output,
 // due to the limitation of generics in Go,
 // the output type somehow must be specified when creating this array.
+//
+// Arrays returned by its operations, like Map, Filter, Slice and Splice,
+// never share storage with the original array, so changing one of them
+// never affects the other. The exceptions are explicit:
+// NewFrom, NewMapperFrom and New(s...) wrap the given slice without a copy,
+// WithType shares the storage of its source,
+// and ToArray returns the underlying slice.
+// Pop and Shift never write to a shared storage, but like Go's append,
+// a Push could reuse its spare capacity.
 type TypedArray[T, U any] struct {
 	array []T
 }
@@ -49,6 +60,11 @@ func NewFrom[T any](items []T) *TypedArray[T, any] {
 	return NewMapperFrom[any](items)
 }
 
+// Empty creates an empty TypedArray.
+func Empty[T any]() *TypedArray[T, any] {
+	return New[T]()
+}
+
 // WithType adds an output type to a single-typed array.
 // This leverages the single-typed array to input-output-typed array
 // to execute Map and FlatMap.
@@ -65,6 +81,8 @@ func WithType[U, T any](t *TypedArray[T, any]) *TypedArray[T, U] {
 // f is applied for each element and the result of every application
 // is stored and returned, if the result is not empty.
 // Returns a new array of type U.
+// For chained type-changing maps, the top-level Map infers U
+// and needs no WithType in between.
 //
 // Example:
 //
@@ -89,6 +107,33 @@ func (m *TypedArray[T, U]) Map(f func(T, int, []T) *O.Optional[U]) *TypedArray[U
 	return New(result...)
 }
 
+// FilterMap is the allocation-free version of Map.
+// f returns an Optional by value, usually created by optional.Some
+// or optional.None, so no Optional is allocated on the heap per element.
+//
+// Example:
+//
+//	a := array.NewMapper[int]("1", "x", "3").FilterMap(
+//		func(s string, _ int, _ []string) optional.Optional[int] {
+//			i, err := strconv.Atoi(s)
+//			if err != nil {
+//				return optional.None[int]()
+//			}
+//			return optional.Some(i)
+//		},
+//	)
+//	fmt.Println(a) // 1, 3
+func (m *TypedArray[T, U]) FilterMap(f func(T, int, []T) O.Optional[U]) *TypedArray[U, any] {
+	result := make([]U, 0)
+	for i, v := range m.array {
+		r := f(v, i, m.array)
+		if r.IsSet() {
+			result = append(result, r.Value())
+		}
+	}
+	return NewFrom(result)
+}
+
 // FlatMap is a flatten version of Map.
 //
 //	f: (item T, index int, array []T) []U
@@ -137,6 +182,29 @@ func (r *TypedArray[T, U]) ForEach(f func(T, int, []T)) *TypedArray[T, U] {
 	return r
 }
 
+// Tap runs a side effect on the whole array mid-chain,
+// like logging or an assertion, and returns the array unchanged.
+// f must not change the slice it is given.
+//
+// Example:
+//
+//	a := array.New(3, 1, 2).
+//		Tap(func(s []int) { log.Println("before:", s) }).
+//		SimpleFilter(isOdd)
+func (r *TypedArray[T, U]) Tap(f func([]T)) *TypedArray[T, U] {
+	f(r.array)
+	return r
+}
+
+// TapEach runs a side effect on each element and its index mid-chain,
+// and returns the array unchanged.
+func (r *TypedArray[T, U]) TapEach(f func(T, int)) *TypedArray[T, U] {
+	for i, v := range r.array {
+		f(v, i)
+	}
+	return r
+}
+
 // A typical Reduce implementation.
 //
 //	f: (accumulator T, item T, index int, array []T) T
@@ -208,52 +276,86 @@ func (r *TypedArray[T, U]) Splice(start int, deleteCount int, items ...T) *Typed
 		r.Push(items...)
 		return NewMapper[U, T]()
 	}
-	if start < 0 {
-		start = len(r.array) + start
-	}
+	start = clampIndex(start, len(r.array))
 	if start+deleteCount > len(r.array) || deleteCount < 0 {
 		deleteCount = len(r.array) - start
 	}
 	deleted := r.Slice(start, start+deleteCount)
-	r.array = append(r.array[:start], append(items, r.array[start+deleteCount:]...)...)
+	// a new backing array is allocated, so neither the deleted elements
+	// nor the caller's items are overwritten
+	r.array = concat(r.array[:start], items, r.array[start+deleteCount:])
 	return deleted
 }
 
-// Slice takes the concept from JavaScript. It returns a new array.
+// Slice takes the concept from JavaScript. It returns a new array,
+// which is a copy independent of the original one.
 // Start index is included, end index is excluded.
 //
 // Different from the basic Go implementation, it is chainable,
 // and start and end both could take negative values.
+// Indices out of range are clamped to the bounds of the array.
 //
 // If start and end do not overlap, or start is too large, it returns an empty array.
 func (r *TypedArray[T, U]) Slice(start int, end int) *TypedArray[T, U] {
-	if start >= len(r.array) {
+	start = clampIndex(start, len(r.array))
+	end = clampIndex(end, len(r.array))
+	if start >= end {
 		return NewMapper[U, T]()
 	}
-	if start < 0 {
-		start = len(r.array) + start
+	return NewMapperFrom[U](slices.Clone(r.array[start:end]))
+}
+
+// concat joins slices into a newly allocated one.
+func concat[T any](parts ...[]T) []T {
+	n := 0
+	for _, p := range parts {
+		n += len(p)
 	}
-	if end < 0 {
-		end = len(r.array) + end
+	result := make([]T, 0, n)
+	for _, p := range parts {
+		result = append(result, p...)
 	}
-	if start >= end {
-		return NewMapper[U, T]()
+	return result
+}
+
+// clampIndex resolves a negative index counted from the end,
+// and clamps it into [0, length].
+func clampIndex(index int, length int) int {
+	if index < 0 {
+		index += length
 	}
-	return NewMapper[U](r.array[start:end]...)
+	return min(max(index, 0), length)
 }
 
 // Index the array with the given index.
-// Supports negative index.
-// If the index is too large, it will dropback to index = -1.
+// Supports negative index, which is counted from the end.
+//
+// For compatibility, an index too large falls back to the last element,
+// which silently hides a wrong index; prefer AtSafe, which does not.
+// It returns a nothing optional if the array is empty,
+// or if a negative index is beyond the start of the array.
 func (r *TypedArray[T, U]) At(index int) *O.Optional[T] {
-	if len(r.array) == 0 {
-		return O.Nothing[T]()
-	}
 	if index >= len(r.array) {
 		index = -1
 	}
+	return r.AtSafe(index)
+}
+
+// AtSafe indexes the array with the given index.
+// A negative index is counted from the end, so -1 is the last element.
+// It returns a nothing optional if the index is out of [-len, len).
+//
+// Example:
+//
+//	a := array.New(1, 2, 3)
+//	a.AtSafe(-1) // Just(3)
+//	a.AtSafe(3)  // Nothing
+func (r *TypedArray[T, U]) AtSafe(index int) *O.Optional[T] {
 	if index < 0 {
-		index = len(r.array) + index
+		index += len(r.array)
+	}
+	if index < 0 || index >= len(r.array) {
+		return O.Nothing[T]()
 	}
 	return O.Just(r.array[index])
 }
@@ -263,6 +365,16 @@ func (r *TypedArray[T, U]) Length() int {
 	return len(r.array)
 }
 
+// IsEmpty checks if the array has no element.
+func (r *TypedArray[T, U]) IsEmpty() bool {
+	return len(r.array) == 0
+}
+
+// NonEmpty checks if the array has at least one element.
+func (r *TypedArray[T, U]) NonEmpty() bool {
+	return len(r.array) > 0
+}
+
 // Push pushes some items at the end of the array.
 func (r *TypedArray[T, U]) Push(items ...T) *TypedArray[T, U] {
 	r.array = append(r.array, items...)
@@ -276,9 +388,11 @@ func (r *TypedArray[T, U]) Pop() *O.Optional[T] {
 	if r.Length() < 1 {
 		return O.Nothing[T]()
 	}
-	popped := r.At(-1)
-	*r = *r.Slice(0, -1)
-	return popped
+	last := len(r.array) - 1
+	popped := r.array[last]
+	// the storage could be shared, so it is resliced without being written
+	r.array = r.array[:last]
+	return O.Just(popped)
 }
 
 // Shift pops an element at the first of the array.
@@ -288,17 +402,23 @@ func (r *TypedArray[T, U]) Shift() *O.Optional[T] {
 	if r.Length() < 1 {
 		return O.Nothing[T]()
 	}
-	shifted := r.At(0)
-	*r = *r.Slice(1, r.Length())
-	return shifted
+	shifted := r.array[0]
+	r.array = r.array[1:]
+	return O.Just(shifted)
 }
 
 // Unshift pushes items at the beginning of the array.
 func (r *TypedArray[T, U]) Unshift(items ...T) *TypedArray[T, U] {
-	r.array = append(items, r.array...)
+	r.array = concat(items, r.array)
 	return r
 }
 
+// DeepClone returns a new array with a deep copy of every element
+// (see structs.Clone), so changing it never affects the original array.
+func (r *TypedArray[T, U]) DeepClone() *TypedArray[T, U] {
+	return NewMapperFrom[U](clone.Deep(r.array))
+}
+
 // Returns a normal array without wrapper.
 func (r *TypedArray[T, U]) ToArray() []T {
 	return r.array
@@ -0,0 +1,104 @@
+package array
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAtSafe(t *testing.T) {
+	a := New(1, 2, 3)
+	tests := []struct {
+		index int
+		want  int
+		set   bool
+	}{
+		{0, 1, true},
+		{2, 3, true},
+		{-1, 3, true},
+		{-3, 1, true},
+		{3, 0, false},
+		{-4, 0, false},
+	}
+	for _, tt := range tests {
+		got := a.AtSafe(tt.index)
+		if got.IsSet() != tt.set || (tt.set && got.Value() != tt.want) {
+			t.Errorf("AtSafe(%d) = %v, want %v (set: %v)", tt.index, got, tt.want, tt.set)
+		}
+	}
+	if Empty[int]().AtSafe(0).IsSet() || Empty[int]().AtSafe(-1).IsSet() {
+		t.Error("AtSafe on an empty array should be nothing")
+	}
+}
+
+func TestAt(t *testing.T) {
+	a := New(1, 2, 3)
+	tests := []struct {
+		index int
+		want  int
+		set   bool
+	}{
+		{1, 2, true},
+		{-1, 3, true},
+		// an index too large falls back to the last element
+		{3, 3, true},
+		{100, 3, true},
+		{-4, 0, false},
+	}
+	for _, tt := range tests {
+		got := a.At(tt.index)
+		if got.IsSet() != tt.set || (tt.set && got.Value() != tt.want) {
+			t.Errorf("At(%d) = %v, want %v (set: %v)", tt.index, got, tt.want, tt.set)
+		}
+	}
+	if Empty[int]().At(0).IsSet() || Empty[int]().At(-1).IsSet() {
+		t.Error("At on an empty array should be nothing")
+	}
+}
+
+func TestSliceIndependent(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	s := a.Slice(1, 3)
+	s.Push(100)
+	s.ToArray()[0] = 200
+	if got := a.ToArray(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("changing a slice changed the original array to %v", got)
+	}
+}
+
+func TestSpliceIndependent(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	deleted := a.Splice(1, 2, 5)
+	a.Push(6, 7, 8)
+	if got := deleted.ToArray(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("deleted elements = %v after pushing to the array, want [2 3]", got)
+	}
+	if got := a.ToArray(); !slices.Equal(got, []int{1, 5, 4, 6, 7, 8}) {
+		t.Errorf("array = %v", got)
+	}
+	items := []int{9, 9}
+	a.Splice(0, 0, items...)
+	a.ToArray()[0] = 0
+	if items[0] != 9 {
+		t.Error("changing the array changed the inserted items")
+	}
+}
+
+func TestPopShiftShared(t *testing.T) {
+	s := []int{1, 2, 3}
+	a := New(s...)
+	a.Pop()
+	a.Shift()
+	if !slices.Equal(s, []int{1, 2, 3}) {
+		t.Errorf("Pop and Shift changed the wrapped slice to %v", s)
+	}
+	b := New(1, 2, 3)
+	typed := WithType[string](b)
+	typed.Pop()
+	typed.Shift()
+	if got := b.ToArray(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Pop and Shift through WithType changed the source to %v", got)
+	}
+	if got := typed.ToArray(); !slices.Equal(got, []int{2}) {
+		t.Errorf("array = %v, want [2]", got)
+	}
+}
@@ -0,0 +1,48 @@
+package array
+
+import "context"
+
+// ToChannel sends the elements of the array to a channel in order
+// from a new goroutine, and closes the channel afterwards.
+// The goroutine stops early once ctx is done, so an abandoned channel
+// does not leak it.
+//
+// Example:
+//
+//	for url := range urls.ToChannel(ctx) {
+//		fetch(url)
+//	}
+func (r *TypedArray[T, U]) ToChannel(ctx context.Context) <-chan T {
+	out := make(chan T)
+	items := r.array
+	go func() {
+		defer close(out)
+		for _, v := range items {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FromChannel drains a channel into an array.
+// It stops once the channel is closed, ctx is done,
+// or limit elements are received. A non-positive limit means no limit.
+func FromChannel[T any](ctx context.Context, ch <-chan T, limit int) *TypedArray[T, any] {
+	result := make([]T, 0)
+	for limit <= 0 || len(result) < limit {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return NewFrom(result)
+			}
+			result = append(result, v)
+		case <-ctx.Done():
+			return NewFrom(result)
+		}
+	}
+	return NewFrom(result)
+}
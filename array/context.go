@@ -0,0 +1,70 @@
+package array
+
+import (
+	"context"
+	"errors"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// The Ctx family checks the context before every element,
+// and stops with the context error once it is done,
+// so a long-running loop inside a request handler could be cancelled.
+
+// ForEachCtx is the context-aware version of ForEach.
+// It returns an OK result after every element is visited,
+// or the context error if ctx is done before that.
+//
+// Example:
+//
+//	r := rows.ForEachCtx(req.Context(), func(row Row, _ int, _ []Row) {
+//		process(row)
+//	})
+//	r.IfErrorThen(log.Println) // context canceled
+func (r *TypedArray[T, U]) ForEachCtx(ctx context.Context, f func(T, int, []T)) *R.Result[struct{}] {
+	for i, v := range r.array {
+		if ctx.Err() != nil {
+			return R.Error[struct{}](context.Cause(ctx))
+		}
+		f(v, i, r.array)
+	}
+	return R.OK(struct{}{})
+}
+
+// MapCtx is the context-aware version of Map.
+// It returns the mapped array, or the context error if ctx is done
+// before every element is mapped.
+func (m *TypedArray[T, U]) MapCtx(ctx context.Context, f func(T, int, []T) *O.Optional[U]) *R.Result[*TypedArray[U, any]] {
+	result := make([]U, 0)
+	for i, v := range m.array {
+		if ctx.Err() != nil {
+			return R.Error[*TypedArray[U, any]](context.Cause(ctx))
+		}
+		r := f(v, i, m.array)
+		if r.IsSet() {
+			result = append(result, r.Value())
+		}
+	}
+	return R.OK(New(result...))
+}
+
+// ReduceCtx is the context-aware version of Reduce.
+// It returns the accumulated value, or an error if the array is empty
+// or ctx is done before every element is accumulated.
+func (r *TypedArray[T, U]) ReduceCtx(ctx context.Context, f func(T, T, int, []T) T) *R.Result[T] {
+	if r.Length() == 0 {
+		return R.Error[T](errors.New("array to reduce must have at least 1 element"))
+	}
+	if ctx.Err() != nil {
+		return R.Error[T](context.Cause(ctx))
+	}
+	result := r.array[0]
+	for i, v := range r.array[1:] {
+		if ctx.Err() != nil {
+			return R.Error[T](context.Cause(ctx))
+		}
+		result = f(result, v, i, r.array)
+	}
+	return R.OK(result)
+}
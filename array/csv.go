@@ -0,0 +1,128 @@
+package array
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/eicc27/Gophunc/internal/fields"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// CSVOptions configures FromCSV.
+type CSVOptions struct {
+	// Comma is the field delimiter. It defaults to ','.
+	Comma rune
+	// Tag is the struct tag naming the column of a field,
+	// falling back to the field name. It defaults to "csv".
+	Tag string
+	// Header names the columns if the input has no header row.
+	// If it is nil, the first row is taken as the header.
+	Header []string
+}
+
+// CSVError reports a row of a CSV input that could not be decoded.
+type CSVError struct {
+	// Line is the line of the row in the input, starting at 1.
+	Line   int
+	Column string
+	Err    error
+}
+
+func (e *CSVError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %q: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+// FromCSV decodes the rows of a CSV input into an array of structs.
+// Columns are matched to exported fields by the name in the struct tag
+// given in opts (see structs.KeysByTag), and unknown columns are ignored.
+// Empty cells leave their fields as zero values.
+//
+// Every row is decoded even if some fail. If any row fails,
+// the result is an error joining a *CSVError for each failed row.
+//
+// Example:
+//
+//	type User struct {
+//		Name string `csv:"name"`
+//		Age  int    `csv:"age"`
+//	}
+//	users := array.FromCSV[User](file, array.CSVOptions{}).AsOK()
+func FromCSV[T any](r io.Reader, opts CSVOptions) *R.Result[*TypedArray[T, any]] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return R.Error[*TypedArray[T, any]](fmt.Errorf("array: FromCSV expects a struct, got %s", t))
+	}
+	if opts.Tag == "" {
+		opts.Tag = "csv"
+	}
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	header := opts.Header
+	if header == nil {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return R.OK(New[T]())
+		}
+		if err != nil {
+			return R.Error[*TypedArray[T, any]](err)
+		}
+		header = row
+	}
+	byName := fields.ByTag(t, opts.Tag)
+	result := New[T]()
+	var errs []error
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return R.Error[*TypedArray[T, any]](err)
+			}
+			errs = append(errs, &CSVError{Line: parseErr.Line, Err: parseErr.Err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		var v T
+		if err := decodeRow(reflect.ValueOf(&v).Elem(), header, row, byName, line); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result.array = append(result.array, v)
+	}
+	if len(errs) > 0 {
+		return R.Error[*TypedArray[T, any]](errors.Join(errs...))
+	}
+	return R.OK(result)
+}
+
+func decodeRow(v reflect.Value, header []string, row []string, byName map[string]int, line int) error {
+	for i, cell := range row {
+		if i >= len(header) {
+			break
+		}
+		index, ok := byName[header[i]]
+		if !ok {
+			continue
+		}
+		if err := fields.Parse(v.Field(index), cell); err != nil {
+			return &CSVError{Line: line, Column: header[i], Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package array
+
+import O "github.com/eicc27/Gophunc/optional"
+
+// Cursor reads the elements of an array one by one,
+// keeping track of the position, like a tokenizer over tokens.
+//
+// Example:
+//
+//	c := tokens.Cursor()
+//	for c.Peek().IsSet() && c.Peek().Value() != ")" {
+//		args = append(args, c.Next().Value())
+//	}
+type Cursor[T any] struct {
+	items []T
+	pos   int
+}
+
+// Cursor creates a Cursor at the start of the array.
+// Elements pushed to the array afterwards are not seen by the cursor.
+func (r *TypedArray[T, U]) Cursor() *Cursor[T] {
+	return &Cursor[T]{
+		items: r.array,
+	}
+}
+
+// Next returns the element at the position and moves past it.
+// It returns a nothing optional at the end of the array.
+func (c *Cursor[T]) Next() *O.Optional[T] {
+	v := c.Peek()
+	if v.IsSet() {
+		c.pos++
+	}
+	return v
+}
+
+// Peek returns the element at the position without moving.
+// It returns a nothing optional at the end of the array.
+func (c *Cursor[T]) Peek() *O.Optional[T] {
+	if c.pos >= len(c.items) {
+		return O.Nothing[T]()
+	}
+	return O.Just(c.items[c.pos])
+}
+
+// Seek moves to the position i. A negative i is counted from the end,
+// and out-of-range positions are clamped to the bounds of the array,
+// where len(array) is the end.
+func (c *Cursor[T]) Seek(i int) *Cursor[T] {
+	c.pos = clampIndex(i, len(c.items))
+	return c
+}
+
+// Index returns the position, which is the index of the next element.
+func (c *Cursor[T]) Index() int {
+	return c.pos
+}
+
+// Remaining returns the number of elements not read yet.
+func (c *Cursor[T]) Remaining() int {
+	return len(c.items) - c.pos
+}
@@ -0,0 +1,29 @@
+package array
+
+// DedupAdjacentBy collapses every run of consecutive equal elements
+// into its first element, where eq tells whether two elements are equal.
+// Different from a set, it needs no hashing and keeps the order,
+// so it is the cheap way to drop duplicates from a sorted array.
+func (r *TypedArray[T, U]) DedupAdjacentBy(eq func(T, T) bool) *TypedArray[T, U] {
+	result := make([]T, 0, len(r.array))
+	for i, v := range r.array {
+		if i == 0 || !eq(r.array[i-1], v) {
+			result = append(result, v)
+		}
+	}
+	return NewMapperFrom[U](result)
+}
+
+// DedupAdjacent collapses every run of consecutive equal elements
+// into its first element, comparing them with ==.
+// It is a top-level function since the elements must be comparable.
+//
+// Example:
+//
+//	a := array.DedupAdjacent(array.New(1, 1, 2, 2, 2, 1))
+//	fmt.Println(a) // 1, 2, 1
+func DedupAdjacent[T comparable, U any](a *TypedArray[T, U]) *TypedArray[T, U] {
+	return a.DedupAdjacentBy(func(x, y T) bool {
+		return x == y
+	})
+}
@@ -0,0 +1,99 @@
+package array
+
+import "fmt"
+
+// EditOp is the kind of an Edit.
+type EditOp int
+
+const (
+	// Keep keeps an element present in both arrays.
+	Keep EditOp = iota
+	// Insert inserts an element only present in the new array.
+	Insert
+	// Delete deletes an element only present in the old array.
+	Delete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case Keep:
+		return " "
+	case Insert:
+		return "+"
+	case Delete:
+		return "-"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is an operation of an edit script produced by Diff.
+// OldIndex is the index of Value in the old array, or -1 for an Insert,
+// and NewIndex is its index in the new array, or -1 for a Delete.
+type Edit[T any] struct {
+	Op       EditOp
+	Value    T
+	OldIndex int
+	NewIndex int
+}
+
+func (e Edit[T]) String() string {
+	return fmt.Sprintf("%v %v", e.Op, e.Value)
+}
+
+// Diff computes an edit script turning the old array from into the new array to,
+// based on their longest common subsequence under equal.
+// Applying the script in order, keeping and inserting elements
+// while skipping deleted ones, gives the new array.
+// It takes O(len(from) * len(to)) time and memory.
+//
+// Example:
+//
+//	edits := array.Diff(array.New("a", "b", "c"), array.New("a", "c", "d"),
+//		func(x, y string) bool { return x == y },
+//	)
+//	edits.SimpleForEach(func(e array.Edit[string]) { fmt.Println(e) })
+//	//   a
+//	// - b
+//	//   c
+//	// + d
+func Diff[T, U, V any](from *TypedArray[T, U], to *TypedArray[T, V], equal func(T, T) bool) *TypedArray[Edit[T], any] {
+	a, b := from.array, to.array
+	n, m := len(a), len(b)
+	// lcs[i][j] is the length of the LCS of a[i:] and b[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if equal(a[i], b[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	edits := make([]Edit[T], 0, max(n, m))
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case equal(a[i], b[j]):
+			edits = append(edits, Edit[T]{Op: Keep, Value: a[i], OldIndex: i, NewIndex: j})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, Edit[T]{Op: Delete, Value: a[i], OldIndex: i, NewIndex: -1})
+			i++
+		default:
+			edits = append(edits, Edit[T]{Op: Insert, Value: b[j], OldIndex: -1, NewIndex: j})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		edits = append(edits, Edit[T]{Op: Delete, Value: a[i], OldIndex: i, NewIndex: -1})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, Edit[T]{Op: Insert, Value: b[j], OldIndex: -1, NewIndex: j})
+	}
+	return NewFrom(edits)
+}
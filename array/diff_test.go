@@ -0,0 +1,66 @@
+package array
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	edits := Diff(New("a", "b", "c"), New("a", "c", "d"), func(x, y string) bool { return x == y })
+	want := []Edit[string]{
+		{Op: Keep, Value: "a", OldIndex: 0, NewIndex: 0},
+		{Op: Delete, Value: "b", OldIndex: 1, NewIndex: -1},
+		{Op: Keep, Value: "c", OldIndex: 2, NewIndex: 1},
+		{Op: Insert, Value: "d", OldIndex: -1, NewIndex: 2},
+	}
+	if !slices.Equal(edits.ToArray(), want) {
+		t.Errorf("Diff = %v, want %v", edits.ToArray(), want)
+	}
+}
+
+func TestDiffEmpty(t *testing.T) {
+	eq := func(x, y int) bool { return x == y }
+	if n := Diff(Empty[int](), Empty[int](), eq).Length(); n != 0 {
+		t.Errorf("Diff of empty arrays has %d edits", n)
+	}
+	for _, e := range Diff(Empty[int](), New(1, 2), eq).ToArray() {
+		if e.Op != Insert {
+			t.Errorf("Diff from an empty array = %v, want only inserts", e)
+		}
+	}
+	for _, e := range Diff(New(1, 2), Empty[int](), eq).ToArray() {
+		if e.Op != Delete {
+			t.Errorf("Diff to an empty array = %v, want only deletes", e)
+		}
+	}
+}
+
+func TestDiffApply(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func() []int {
+		s := make([]int, rng.Intn(12))
+		for i := range s {
+			s[i] = rng.Intn(4)
+		}
+		return s
+	}
+	for run := 0; run < 200; run++ {
+		from, to := random(), random()
+		applied := make([]int, 0, len(to))
+		for _, e := range Diff(NewFrom(from), NewFrom(to), func(x, y int) bool { return x == y }).ToArray() {
+			switch e.Op {
+			case Keep:
+				if from[e.OldIndex] != e.Value || to[e.NewIndex] != e.Value {
+					t.Fatalf("Diff(%v, %v): bad indices in %+v", from, to, e)
+				}
+				applied = append(applied, e.Value)
+			case Insert:
+				applied = append(applied, e.Value)
+			}
+		}
+		if !slices.Equal(applied, to) {
+			t.Fatalf("applying Diff(%v, %v) gives %v", from, to, applied)
+		}
+	}
+}
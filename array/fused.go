@@ -0,0 +1,94 @@
+package array
+
+// Fused is a pipeline of element-wise operations over a TypedArray,
+// which runs as a single loop once it is materialized.
+// Different from chaining SimpleMap and SimpleFilter, no intermediate
+// array is allocated between the stages.
+//
+// Example:
+//
+//	a := array.New(1, 2, 3, 4).Fused().
+//		Map(func(i int) int { return i * i }).
+//		Filter(func(i int) bool { return i%2 == 0 }).
+//		ToArray() // 4, 16
+type Fused[T any] struct {
+	each func(yield func(T) bool)
+}
+
+// Fused starts a fused pipeline over the elements of the array.
+// The array is read when the pipeline is materialized,
+// so changes made to it before that are seen by the pipeline.
+func (r *TypedArray[T, U]) Fused() *Fused[T] {
+	return &Fused[T]{
+		each: func(yield func(T) bool) {
+			for _, v := range r.array {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Map adds a stage applying f to each element.
+func (p *Fused[T]) Map(f func(T) T) *Fused[T] {
+	return FusedMap(p, f)
+}
+
+// Filter adds a stage keeping the elements that satisfy f.
+func (p *Fused[T]) Filter(f func(T) bool) *Fused[T] {
+	return &Fused[T]{
+		each: func(yield func(T) bool) {
+			p.each(func(v T) bool {
+				return !f(v) || yield(v)
+			})
+		},
+	}
+}
+
+// Take adds a stage keeping the first n elements.
+// The loop stops as soon as n elements are taken.
+func (p *Fused[T]) Take(n int) *Fused[T] {
+	return &Fused[T]{
+		each: func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
+			taken := 0
+			p.each(func(v T) bool {
+				taken++
+				return yield(v) && taken < n
+			})
+		},
+	}
+}
+
+// ForEach runs the pipeline and applies f to each resulting element.
+func (p *Fused[T]) ForEach(f func(T)) {
+	p.each(func(v T) bool {
+		f(v)
+		return true
+	})
+}
+
+// ToArray runs the pipeline and collects the resulting elements.
+func (p *Fused[T]) ToArray() *TypedArray[T, any] {
+	result := make([]T, 0)
+	p.each(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return NewFrom(result)
+}
+
+// FusedMap adds a stage applying f to each element of a pipeline.
+// It is a top-level function since the type of elements could change.
+func FusedMap[T, V any](p *Fused[T], f func(T) V) *Fused[V] {
+	return &Fused[V]{
+		each: func(yield func(V) bool) {
+			p.each(func(v T) bool {
+				return yield(f(v))
+			})
+		},
+	}
+}
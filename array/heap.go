@@ -0,0 +1,33 @@
+package array
+
+// lessHeap adapts a slice ordered by less to container/heap.
+// The heap package could not be used here, as it imports this one.
+type lessHeap[T any] struct {
+	items []T
+	less  func(T, T) bool
+}
+
+func (h *lessHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *lessHeap[T]) Less(i int, j int) bool {
+	return h.less(h.items[i], h.items[j])
+}
+
+func (h *lessHeap[T]) Swap(i int, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *lessHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(T))
+}
+
+func (h *lessHeap[T]) Pop() any {
+	last := len(h.items) - 1
+	x := h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	return x
+}
@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package array
+
+import "iter"
+
+// Iter returns an iterator over the elements of the array,
+// so it could be ranged over with `for v := range a.Iter()`.
+func (t *TypedArray[T, U]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range t.array {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Iter2 returns an iterator over the indices and elements of the array.
+func (t *TypedArray[T, U]) Iter2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range t.array {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq collects the values of an iterator into a TypedArray.
+func FromSeq[T any](seq iter.Seq[T]) *TypedArray[T, any] {
+	result := New[T]()
+	for v := range seq {
+		result.array = append(result.array, v)
+	}
+	return result
+}
+
+// Iter returns an iterator over the key-value pairs of the map,
+// so it could be ranged over with `for k, v := range m.Iter()`.
+// Like a Go map, the order is not specified.
+func (m *TypedMap[T, U]) Iter() iter.Seq2[T, U] {
+	return func(yield func(T, U) bool) {
+		for k, v := range m.m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq2 collects the key-value pairs of an iterator into a TypedMap.
+// If a key appears several times, the last value wins.
+func FromSeq2[T comparable, U any](seq iter.Seq2[T, U]) *TypedMap[T, U] {
+	result := NewTypedMap[T, U]()
+	for k, v := range seq {
+		result.m[k] = v
+	}
+	return result
+}
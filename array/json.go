@@ -0,0 +1,17 @@
+package array
+
+import (
+	"encoding/json"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// FromJSON decodes a JSON array into a TypedArray.
+// For a large payload read from an io.Reader, use stream.FromJSONArray instead.
+func FromJSON[T any](data []byte) *R.Result[*TypedArray[T, any]] {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return R.Error[*TypedArray[T, any]](err)
+	}
+	return R.OK(NewFrom(items))
+}
@@ -1,8 +1,16 @@
 package array
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/eicc27/Gophunc/internal/clone"
 	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
 	"github.com/eicc27/Gophunc/set"
+	"github.com/eicc27/Gophunc/tuple"
 )
 
 type TypedMap[T comparable, U any] struct {
@@ -23,6 +31,64 @@ func NewTypedMapFrom[T comparable, U any](m map[T]U) *TypedMap[T, U] {
 	}
 }
 
+// FromEntries creates a new TypedMap from an array of key-value pairs,
+// which is the reverse of Entries.
+// If a key appears several times, the last value wins.
+func FromEntries[T comparable, U, V any](entries *TypedArray[tuple.Pair[T, U], V]) *TypedMap[T, U] {
+	return NewTypedMapOf(entries.array...)
+}
+
+// NewTypedMapOf creates a new TypedMap from key-value pairs.
+// If a key appears several times, the last value wins.
+//
+// Example:
+//
+//	m := array.NewTypedMapOf(tuple.NewPair("a", 1), tuple.NewPair("b", 2))
+func NewTypedMapOf[T comparable, U any](entries ...tuple.Pair[T, U]) *TypedMap[T, U] {
+	m := make(map[T]U, len(entries))
+	for _, e := range entries {
+		m[e.First] = e.Second
+	}
+	return NewTypedMapFrom(m)
+}
+
+// MapOf creates a new TypedMap from keys and values alternating
+// in kvs, like a map literal at the end of a pipeline.
+// If a key appears several times, the last value wins.
+// It fails if kvs has an odd length, or if a key or a value
+// is not of the type of the map.
+//
+// Example:
+//
+//	m := array.MapOf[string, int]("a", 1, "b", 2).AsOK()
+func MapOf[T comparable, U any](kvs ...any) *R.Result[*TypedMap[T, U]] {
+	if len(kvs)%2 != 0 {
+		return R.Error[*TypedMap[T, U]](errors.New("keys and values must come in pairs"))
+	}
+	m := make(map[T]U, len(kvs)/2)
+	for i := 0; i < len(kvs); i += 2 {
+		k, ok := kvs[i].(T)
+		if !ok && !(kvs[i] == nil && nilable[T]()) {
+			return R.Error[*TypedMap[T, U]](fmt.Errorf("key %d: %T is not a %T", i/2, kvs[i], k))
+		}
+		v, ok := kvs[i+1].(U)
+		if !ok && !(kvs[i+1] == nil && nilable[U]()) {
+			return R.Error[*TypedMap[T, U]](fmt.Errorf("value %d: %T is not a %T", i/2, kvs[i+1], v))
+		}
+		m[k] = v
+	}
+	return R.OK(NewTypedMapFrom(m))
+}
+
+// nilable checks if a nil passed as any could stand for a T.
+func nilable[T any]() bool {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
+
 // Get returns the value of the key.
 func (m *TypedMap[T, U]) Get(key T) *O.Optional[U] {
 	if v, ok := m.m[key]; ok {
@@ -31,6 +97,37 @@ func (m *TypedMap[T, U]) Get(key T) *O.Optional[U] {
 	return O.Nothing[U]()
 }
 
+// GetOrDefault returns the value of the key,
+// or def if the key does not exist.
+func (m *TypedMap[T, U]) GetOrDefault(key T, def U) U {
+	if v, ok := m.m[key]; ok {
+		return v
+	}
+	return def
+}
+
+// GetOrSet returns the value of the key.
+// If the key does not exist, def is set as its value and returned.
+func (m *TypedMap[T, U]) GetOrSet(key T, def U) U {
+	if v, ok := m.m[key]; ok {
+		return v
+	}
+	m.m[key] = def
+	return def
+}
+
+// ComputeIfAbsent returns the value of the key.
+// If the key does not exist, f is called to compute the value,
+// which is then set and returned. f is not called if the key exists.
+func (m *TypedMap[T, U]) ComputeIfAbsent(key T, f func(T) U) U {
+	if v, ok := m.m[key]; ok {
+		return v
+	}
+	v := f(key)
+	m.m[key] = v
+	return v
+}
+
 // Set sets the value of the key.
 // If the key does not exist, it will be created.
 func (m *TypedMap[T, U]) Set(key T, value U) *TypedMap[T, U] {
@@ -38,12 +135,99 @@ func (m *TypedMap[T, U]) Set(key T, value U) *TypedMap[T, U] {
 	return m
 }
 
-// Delete deletes the key.
-func (m *TypedMap[T, U]) Delete(key T) *TypedMap[T, U] {
+// Update applies f to the current value of the key, and writes the result back.
+// If the key does not exist, f gets a nothing optional.
+// If f returns a nothing optional, the key is deleted.
+//
+// Example:
+//
+//	counts.Update(word, func(o *optional.Optional[int]) *optional.Optional[int] {
+//		if !o.IsSet() {
+//			return optional.Just(1)
+//		}
+//		return optional.Just(o.Value() + 1)
+//	})
+func (m *TypedMap[T, U]) Update(key T, f func(*O.Optional[U]) *O.Optional[U]) *TypedMap[T, U] {
+	r := f(m.Get(key))
+	if r.IsSet() {
+		m.m[key] = r.Value()
+	} else {
+		delete(m.m, key)
+	}
+	return m
+}
+
+// Delete deletes the key and returns the deleted value.
+// If the key does not exist, it does nothing and returns a nothing optional.
+func (m *TypedMap[T, U]) Delete(key T) *O.Optional[U] {
+	v, ok := m.m[key]
+	if !ok {
+		return O.Nothing[U]()
+	}
 	delete(m.m, key)
+	return O.Just(v)
+}
+
+// Has checks if the key exists.
+func (m *TypedMap[T, U]) Has(key T) bool {
+	_, ok := m.m[key]
+	return ok
+}
+
+// Len returns the number of keys in the map.
+func (m *TypedMap[T, U]) Len() int {
+	return len(m.m)
+}
+
+// IsEmpty checks if the map has no key.
+func (m *TypedMap[T, U]) IsEmpty() bool {
+	return len(m.m) == 0
+}
+
+// NonEmpty checks if the map has at least one key.
+func (m *TypedMap[T, U]) NonEmpty() bool {
+	return len(m.m) > 0
+}
+
+// Clear deletes all keys from the map.
+func (m *TypedMap[T, U]) Clear() *TypedMap[T, U] {
+	clear(m.m)
 	return m
 }
 
+// Clone returns a shallow copy of the map.
+// Different from NewTypedMapFrom, the copy does not share
+// the underlying map with the original one.
+func (m *TypedMap[T, U]) Clone() *TypedMap[T, U] {
+	c := make(map[T]U, len(m.m))
+	for k, v := range m.m {
+		c[k] = v
+	}
+	return NewTypedMapFrom(c)
+}
+
+// Merge sets all key-value pairs of other into the map, in place.
+//
+//	resolve: (key T, current U, incoming U) U
+//
+// For a key that exists in both maps, resolve decides the value to keep.
+// If resolve is nil, the value from other wins.
+func (m *TypedMap[T, U]) Merge(other *TypedMap[T, U], resolve func(T, U, U) U) *TypedMap[T, U] {
+	for k, v := range other.m {
+		if current, ok := m.m[k]; ok && resolve != nil {
+			v = resolve(k, current, v)
+		}
+		m.m[k] = v
+	}
+	return m
+}
+
+// DeepClone returns a deep copy of the map (see structs.Clone),
+// in which the values are copied recursively as well.
+func (m *TypedMap[T, U]) DeepClone() *TypedMap[T, U] {
+	return NewTypedMapFrom(clone.Deep(m.m))
+}
+
 // Keys returns the keys of the map.
 func (m *TypedMap[T, U]) Keys() *TypedArray[T, any] {
 	keys := make([]T, 0)
@@ -53,6 +237,15 @@ func (m *TypedMap[T, U]) Keys() *TypedArray[T, any] {
 	return New(keys...)
 }
 
+// KeysSorted returns the keys of the map sorted by less.
+func (m *TypedMap[T, U]) KeysSorted(less func(T, T) bool) *TypedArray[T, any] {
+	keys := m.Keys()
+	sort.Slice(keys.array, func(i, j int) bool {
+		return less(keys.array[i], keys.array[j])
+	})
+	return keys
+}
+
 // Values returns the values of the map.
 func (m *TypedMap[T, U]) Values() *TypedArray[U, any] {
 	values := make([]U, 0)
@@ -62,6 +255,25 @@ func (m *TypedMap[T, U]) Values() *TypedArray[U, any] {
 	return New(values...)
 }
 
+// ForEachSorted applies f to each key-value pair in the order of keys sorted by less.
+// It is useful when a deterministic order is needed, e.g. for digests.
+func (m *TypedMap[T, U]) ForEachSorted(less func(T, T) bool, f func(T, U)) *TypedMap[T, U] {
+	for _, k := range m.KeysSorted(less).array {
+		f(k, m.m[k])
+	}
+	return m
+}
+
+// Entries returns the key-value pairs of the map.
+// The first of each pair is the key and the second is the value.
+func (m *TypedMap[T, U]) Entries() *TypedArray[tuple.Pair[T, U], any] {
+	entries := make([]tuple.Pair[T, U], 0, len(m.m))
+	for k, v := range m.m {
+		entries = append(entries, tuple.NewPair(k, v))
+	}
+	return New(entries...)
+}
+
 // ForEach applies f to each key-value pair.
 func (m *TypedMap[T, U]) ForEach(f func(T, U)) *TypedMap[T, U] {
 	for k, v := range m.m {
@@ -70,6 +282,17 @@ func (m *TypedMap[T, U]) ForEach(f func(T, U)) *TypedMap[T, U] {
 	return m
 }
 
+// Filter gets all key-value pairs that satisfy the predicate f as a new map.
+func (m *TypedMap[T, U]) Filter(f func(T, U) bool) *TypedMap[T, U] {
+	result := NewTypedMap[T, U]()
+	for k, v := range m.m {
+		if f(k, v) {
+			result.m[k] = v
+		}
+	}
+	return result
+}
+
 // ToSet converts the keys of the map to a set.
 func (m *TypedMap[T, U]) ToSet() set.Set[T] {
 	s := set.New[T]()
@@ -83,12 +306,62 @@ func (m *TypedMap[T, U]) ToSet() set.Set[T] {
 func GroupBy[K comparable, U, V any](a *TypedArray[U, V], f func(U, int, []U) K) *TypedMap[K, *TypedArray[U, V]] {
 	m := NewTypedMap[K, *TypedArray[U, V]]()
 	for i, v := range a.array {
-		key := f(v, i, a.array)
-		if !m.Get(key).IsSet() {
-			m.Set(key, NewMapper[V](v))
-		} else {
-			m.Get(key).Value().Push(v)
-		}
+		m.ComputeIfAbsent(f(v, i, a.array), func(_ K) *TypedArray[U, V] {
+			return NewMapper[V, U]()
+		}).Push(v)
 	}
 	return m
 }
+
+// Histogram counts the elements of the array by the key returned by f.
+// Different from GroupBy, it keeps only the count of each group.
+//
+// Example:
+//
+//	byStatus := array.Histogram(responses, func(r Response) int { return r.Status })
+//	fmt.Println(byStatus.Get(404).Value()) // 3
+func Histogram[K comparable, U, V any](a *TypedArray[U, V], f func(U) K) *TypedMap[K, int] {
+	m := NewTypedMap[K, int]()
+	for _, v := range a.array {
+		m.m[f(v)]++
+	}
+	return m
+}
+
+// MapValues applies f to each value of the map and returns a new map
+// with the same keys.
+// It is a top-level function since the type of values could change.
+func MapValues[T comparable, U, V any](m *TypedMap[T, U], f func(U) V) *TypedMap[T, V] {
+	result := NewTypedMap[T, V]()
+	for k, v := range m.m {
+		result.m[k] = f(v)
+	}
+	return result
+}
+
+// MapKeys applies f to each key of the map and returns a new map
+// with the same values.
+// If f maps several keys to the same key, only one of the values is kept
+// and which one is not specified.
+func MapKeys[T, K comparable, U any](m *TypedMap[T, U], f func(T) K) *TypedMap[K, U] {
+	result := NewTypedMap[K, U]()
+	for k, v := range m.m {
+		result.m[f(k)] = v
+	}
+	return result
+}
+
+// MergeAll merges maps from left to right into a new map.
+// Conflicts are resolved by resolve as in Merge,
+// so with a nil resolve the rightmost value wins.
+//
+// Example:
+//
+//	config := array.MergeAll(nil, defaults, fromFile, fromEnv)
+func MergeAll[T comparable, U any](resolve func(T, U, U) U, maps ...*TypedMap[T, U]) *TypedMap[T, U] {
+	result := NewTypedMap[T, U]()
+	for _, m := range maps {
+		result.Merge(m, resolve)
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/eicc27/Gophunc/tuple"
+)
+
+func TestMapOf(t *testing.T) {
+	r := MapOf[string, int]("a", 1, "b", 2, "a", 3)
+	if !r.IsOK() {
+		t.Fatalf("MapOf failed: %v", r.AsError())
+	}
+	m := r.AsOK()
+	if m.Len() != 2 || m.Get("a").Value() != 3 || m.Get("b").Value() != 2 {
+		t.Errorf("MapOf = %v, want a: 3, b: 2", m.m)
+	}
+	if MapOf[string, int]("a").IsOK() {
+		t.Error("MapOf with an odd number of arguments should fail")
+	}
+	if MapOf[string, int](1, 1).IsOK() || MapOf[string, int]("a", "b").IsOK() {
+		t.Error("MapOf with mistyped arguments should fail")
+	}
+	if !MapOf[string, *int]("a", nil).IsOK() {
+		t.Error("MapOf should accept nil for a pointer value")
+	}
+}
+
+func TestFromEntries(t *testing.T) {
+	m := FromEntries(New(tuple.NewPair("a", 1), tuple.NewPair("a", 2)))
+	if m.Len() != 1 || m.Get("a").Value() != 2 {
+		t.Errorf("FromEntries = %v, want the last value to win", m.m)
+	}
+}
@@ -0,0 +1,52 @@
+package array
+
+import "container/heap"
+
+// mergeCursor points at the next element of one of the merged arrays.
+type mergeCursor[T any] struct {
+	items []T
+	index int
+	from  int
+}
+
+// MergeSorted merges arrays, each already sorted by less,
+// into a single sorted array. It keeps a heap of the head of each array,
+// which takes O(total * log k) for k arrays.
+// The merge is stable: equal elements keep the order of their arrays.
+//
+// Example:
+//
+//	merged := array.MergeSorted(func(a, b LogLine) bool {
+//		return a.Time.Before(b.Time)
+//	}, shard1, shard2, shard3)
+func MergeSorted[T, U any](less func(T, T) bool, arrays ...*TypedArray[T, U]) *TypedArray[T, U] {
+	h := &lessHeap[mergeCursor[T]]{
+		less: func(a, b mergeCursor[T]) bool {
+			x, y := a.items[a.index], b.items[b.index]
+			if less(x, y) {
+				return true
+			}
+			return !less(y, x) && a.from < b.from
+		},
+	}
+	total := 0
+	for i, a := range arrays {
+		if len(a.array) > 0 {
+			h.items = append(h.items, mergeCursor[T]{items: a.array, from: i})
+			total += len(a.array)
+		}
+	}
+	heap.Init(h)
+	result := make([]T, 0, total)
+	for h.Len() > 0 {
+		c := &h.items[0]
+		result = append(result, c.items[c.index])
+		c.index++
+		if c.index < len(c.items) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return NewMapperFrom[U](result)
+}
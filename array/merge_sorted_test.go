@@ -0,0 +1,34 @@
+package array
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	got := MergeSorted(less, New(1, 4, 7), Empty[int](), New(2, 5), New(3, 6, 8, 9)).ToArray()
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSorted = %v, want %v", got, want)
+	}
+	if n := MergeSorted[int, any](less).Length(); n != 0 {
+		t.Errorf("MergeSorted of no arrays has %d elements", n)
+	}
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	type item struct {
+		key  int
+		from string
+	}
+	less := func(a, b item) bool { return a.key < b.key }
+	got := MergeSorted(less,
+		New(item{1, "a"}, item{2, "a"}),
+		New(item{1, "b"}, item{2, "b"}),
+	).ToArray()
+	want := []item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSorted = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package array
+
+import "slices"
+
+// MultiMap maps each key to an array of values.
+// A key exists in the map as long as it has at least one value.
+type MultiMap[K comparable, V any] struct {
+	m map[K][]V
+}
+
+// NewMultiMap creates a new MultiMap.
+func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		m: make(map[K][]V),
+	}
+}
+
+// NewMultiMapFrom creates a new MultiMap from the output of GroupBy.
+//
+// Example:
+//
+//	m := array.NewMultiMapFrom(array.GroupBy(users, byTeam))
+//	m.Add("ops", newcomer)
+func NewMultiMapFrom[K comparable, U, V any](groups *TypedMap[K, *TypedArray[U, V]]) *MultiMap[K, U] {
+	result := NewMultiMap[K, U]()
+	for k, v := range groups.m {
+		if v.Length() > 0 {
+			result.m[k] = slices.Clone(v.array)
+		}
+	}
+	return result
+}
+
+// Add appends values to the key.
+func (m *MultiMap[K, V]) Add(key K, values ...V) *MultiMap[K, V] {
+	if len(values) > 0 {
+		m.m[key] = append(m.m[key], values...)
+	}
+	return m
+}
+
+// Get returns a copy of the values of the key.
+// If the key does not exist, it returns an empty array.
+func (m *MultiMap[K, V]) Get(key K) *TypedArray[V, any] {
+	return New(slices.Clone(m.m[key])...)
+}
+
+// Has checks if the key has any value.
+func (m *MultiMap[K, V]) Has(key K) bool {
+	_, ok := m.m[key]
+	return ok
+}
+
+// RemoveValue removes the values of the key that satisfy f.
+// If no value is left, the key is removed as well.
+func (m *MultiMap[K, V]) RemoveValue(key K, f func(V) bool) *MultiMap[K, V] {
+	values, ok := m.m[key]
+	if !ok {
+		return m
+	}
+	values = slices.DeleteFunc(values, f)
+	if len(values) == 0 {
+		delete(m.m, key)
+	} else {
+		m.m[key] = values
+	}
+	return m
+}
+
+// Delete removes the key with all its values.
+func (m *MultiMap[K, V]) Delete(key K) *MultiMap[K, V] {
+	delete(m.m, key)
+	return m
+}
+
+// Len returns the number of keys.
+func (m *MultiMap[K, V]) Len() int {
+	return len(m.m)
+}
+
+// Size returns the total number of values of all keys.
+func (m *MultiMap[K, V]) Size() int {
+	size := 0
+	for _, v := range m.m {
+		size += len(v)
+	}
+	return size
+}
+
+// Keys returns the keys of the map.
+func (m *MultiMap[K, V]) Keys() *TypedArray[K, any] {
+	keys := make([]K, 0, len(m.m))
+	for k := range m.m {
+		keys = append(keys, k)
+	}
+	return New(keys...)
+}
+
+// ForEach applies f to each key-value pair.
+// A key with several values is visited once per value.
+func (m *MultiMap[K, V]) ForEach(f func(K, V)) *MultiMap[K, V] {
+	for k, values := range m.m {
+		for _, v := range values {
+			f(k, v)
+		}
+	}
+	return m
+}
+
+// ToTypedMap converts the map to a TypedMap of arrays.
+// The arrays are copies, so changing them does not affect the MultiMap.
+func (m *MultiMap[K, V]) ToTypedMap() *TypedMap[K, *TypedArray[V, any]] {
+	result := NewTypedMap[K, *TypedArray[V, any]]()
+	for k, v := range m.m {
+		result.m[k] = New(slices.Clone(v)...)
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package array
+
+// Pages splits an array into pages of a fixed size.
+// Pages are numbered from 1, as they usually are in APIs.
+//
+// Example:
+//
+//	pages := results.Paginate(20)
+//	page := pages.Page(req.PageNumber)
+//	resp.Header().Set("X-Total-Pages", strconv.Itoa(pages.TotalPages()))
+type Pages[T, U any] struct {
+	array *TypedArray[T, U]
+	size  int
+}
+
+// Paginate splits the array into pages of pageSize elements,
+// the last of which could be shorter.
+// A non-positive pageSize puts all elements into a single page.
+func (r *TypedArray[T, U]) Paginate(pageSize int) *Pages[T, U] {
+	if pageSize <= 0 {
+		pageSize = max(len(r.array), 1)
+	}
+	return &Pages[T, U]{
+		array: r,
+		size:  pageSize,
+	}
+}
+
+// Page returns a copy of the elements of the page n, counted from 1.
+// A page out of range is empty.
+func (p *Pages[T, U]) Page(n int) *TypedArray[T, U] {
+	if n < 1 || n > p.TotalPages() {
+		return NewMapper[U, T]()
+	}
+	start := (n - 1) * p.size
+	return p.array.Slice(start, start+p.size)
+}
+
+// TotalPages returns the number of pages.
+// An empty array has no page.
+func (p *Pages[T, U]) TotalPages() int {
+	return (p.array.Length() + p.size - 1) / p.size
+}
+
+// PageSize returns the number of elements of a full page.
+func (p *Pages[T, U]) PageSize() int {
+	return p.size
+}
+
+// ForEachPage applies f to each page and its number in order.
+func (p *Pages[T, U]) ForEachPage(f func(*TypedArray[T, U], int)) *Pages[T, U] {
+	for n := 1; n <= p.TotalPages(); n++ {
+		f(p.Page(n), n)
+	}
+	return p
+}
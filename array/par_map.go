@@ -0,0 +1,125 @@
+package array
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ParMap applies f to each element of an array in parallel,
+// with at most workers goroutines. A non-positive workers means GOMAXPROCS.
+// The results keep the order of the input array.
+//
+// Example:
+//
+//	thumbnails := array.ParMap(images, resize, 8)
+func ParMap[T, U, V any](a *TypedArray[T, V], f func(T) U, workers int) *TypedArray[U, any] {
+	workers = parWorkers(workers)
+	chunk := max(len(a.array)/(workers*4), 1)
+	return FromChannel(context.Background(), ParMapStream(context.Background(), a, f, chunk, workers), 0)
+}
+
+// ParMapStream applies f to each element of an array in parallel like ParMap,
+// but sends the results to a channel as soon as possible,
+// so consumers could start before the whole array is mapped.
+//
+// The array is split into chunks of chunkSize elements, which are mapped
+// by workers concurrently. The results are still sent in the input order:
+// a chunk is sent once it and all the chunks before it are done.
+// At most 2 * workers chunks are pending at the same time,
+// which bounds the memory held by a slow consumer.
+// A non-positive chunkSize means 1.
+//
+// The channel is closed after the last result, or once ctx is done
+// and the chunks being mapped at that moment are finished,
+// so no worker outlives the channel.
+//
+// Example:
+//
+//	for row := range array.ParMapStream(ctx, ids, fetchRow, 16, 8) {
+//		writer.Write(row) // starts after the first chunk
+//	}
+func ParMapStream[T, U, V any](ctx context.Context, a *TypedArray[T, V], f func(T) U, chunkSize int, workers int) <-chan U {
+	workers = parWorkers(workers)
+	chunkSize = max(chunkSize, 1)
+	items := a.array
+	chunks := (len(items) + chunkSize - 1) / chunkSize
+	results := make([][]U, chunks)
+	done := make([]chan struct{}, chunks)
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	// a token is taken when a chunk is dispatched,
+	// and given back when it is sent
+	tokens := make(chan struct{}, 2*workers)
+	jobs := make(chan int)
+	out := make(chan U)
+
+	go func() {
+		defer close(jobs)
+		for i := 0; i < chunks; i++ {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				start := i * chunkSize
+				end := min(start+chunkSize, len(items))
+				mapped := make([]U, end-start)
+				for j, v := range items[start:end] {
+					mapped[j] = f(v)
+				}
+				results[i] = mapped
+				close(done[i])
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < chunks; i++ {
+			select {
+			case <-done[i]:
+			case <-ctx.Done():
+				return
+			}
+			for _, v := range results[i] {
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+			results[i] = nil
+			<-tokens
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func parWorkers(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
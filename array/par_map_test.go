@@ -0,0 +1,56 @@
+package array
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParMapStream(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+	for _, chunkSize := range []int{0, 1, 7, 200} {
+		got := make([]int, 0, len(items))
+		for v := range ParMapStream(context.Background(), NewFrom(items), func(i int) int { return i * 2 }, chunkSize, 4) {
+			got = append(got, v)
+		}
+		for i, v := range got {
+			if v != i*2 {
+				t.Fatalf("chunkSize %d: got %v, want doubled input in order", chunkSize, got)
+			}
+		}
+		if len(got) != len(items) {
+			t.Errorf("chunkSize %d: got %d results, want %d", chunkSize, len(got), len(items))
+		}
+	}
+}
+
+func TestParMapStreamCancel(t *testing.T) {
+	var running atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ParMapStream(ctx, New(1, 2, 3, 4, 5, 6, 7, 8), func(i int) int {
+		running.Add(1)
+		defer running.Add(-1)
+		time.Sleep(10 * time.Millisecond)
+		return i
+	}, 1, 2)
+	<-ch
+	cancel()
+	for range ch {
+	}
+	// the channel is closed only after the workers return
+	if n := running.Load(); n != 0 {
+		t.Errorf("%d workers still running after the channel is closed", n)
+	}
+}
+
+func TestParMap(t *testing.T) {
+	got := ParMap(New(3, 1, 2), func(i int) int { return i + 1 }, 0).ToArray()
+	if want := []int{4, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("ParMap = %v, want %v", got, want)
+	}
+}
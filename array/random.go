@@ -0,0 +1,74 @@
+package array
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Choice picks a random element of the array with rng.
+// A nil rng uses the default source of math/rand.
+// It returns a nothing optional if the array is empty.
+func (r *TypedArray[T, U]) Choice(rng *rand.Rand) *O.Optional[T] {
+	if len(r.array) == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(r.array[intn(rng, len(r.array))])
+}
+
+// WeightedSample draws n random elements of the array with replacement,
+// where an element is drawn with a probability proportional to its weight.
+// A nil rng uses the default source of math/rand.
+//
+// It fails if weights do not match the elements one by one,
+// if any weight is negative, or if all weights are zero.
+//
+// Example:
+//
+//	endpoints := array.New("/home", "/search", "/checkout")
+//	requests := endpoints.WeightedSample([]float64{7, 2, 1}, 1000, nil)
+func (r *TypedArray[T, U]) WeightedSample(weights []float64, n int, rng *rand.Rand) *R.Result[*TypedArray[T, U]] {
+	if len(weights) != len(r.array) {
+		return R.Error[*TypedArray[T, U]](errors.New("weights must match the elements one by one"))
+	}
+	cumulative := make([]float64, len(weights))
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 {
+			return R.Error[*TypedArray[T, U]](errors.New("weights must not be negative"))
+		}
+		total += w
+		cumulative[i] = total
+	}
+	if total <= 0 {
+		return R.Error[*TypedArray[T, U]](errors.New("weights must not all be zero"))
+	}
+	result := make([]T, max(n, 0))
+	for i := range result {
+		x := float64Of(rng) * total
+		// the first element whose cumulative weight exceeds x,
+		// which skips elements of zero weight
+		j := sort.Search(len(cumulative), func(k int) bool {
+			return cumulative[k] > x
+		})
+		result[i] = r.array[min(j, len(r.array)-1)]
+	}
+	return R.OK(NewMapperFrom[U](result))
+}
+
+func intn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+func float64Of(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
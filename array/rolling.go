@@ -0,0 +1,62 @@
+package array
+
+// RollingReduce reduces every sliding window of the given size with f,
+// from left to right like Reduce, and returns the aggregate of each window.
+// An array of n elements has n-window+1 windows, so the result is empty
+// if window is not positive or larger than the array.
+//
+// The windows are views of the array, so no window is allocated.
+// For an aggregate that could be undone, like a sum,
+// RollingFold updates each window in O(1) instead.
+//
+// Example:
+//
+//	maxima := array.New(1, 3, 2, 5, 4).RollingReduce(3, func(a, b int) int {
+//		return max(a, b)
+//	})
+//	fmt.Println(maxima) // 3, 5, 5
+func (r *TypedArray[T, U]) RollingReduce(window int, f func(T, T) T) *TypedArray[T, U] {
+	if window <= 0 || window > len(r.array) {
+		return NewMapper[U, T]()
+	}
+	result := make([]T, 0, len(r.array)-window+1)
+	for start := 0; start+window <= len(r.array); start++ {
+		acc := r.array[start]
+		for _, v := range r.array[start+1 : start+window] {
+			acc = f(acc, v)
+		}
+		result = append(result, acc)
+	}
+	return NewMapperFrom[U](result)
+}
+
+// RollingFold computes the aggregate of every sliding window of the given size
+// in a single pass. Starting from init, add adds the element entering a window,
+// and remove undoes the element leaving it, so each window takes O(1).
+// Like RollingReduce, the result has an aggregate for each full window.
+//
+// Example:
+//
+//	sums := array.RollingFold(array.New(1, 2, 3, 4), 2, 0,
+//		func(acc, v int) int { return acc + v },
+//		func(acc, v int) int { return acc - v },
+//	)
+//	fmt.Println(sums) // 3, 5, 7
+//	averages := array.Map(sums, func(s int) float64 { return float64(s) / 2 })
+func RollingFold[T, A, U any](a *TypedArray[T, U], window int, init A, add func(A, T) A, remove func(A, T) A) *TypedArray[A, any] {
+	if window <= 0 || window > len(a.array) {
+		return New[A]()
+	}
+	result := make([]A, 0, len(a.array)-window+1)
+	acc := init
+	for i, v := range a.array {
+		acc = add(acc, v)
+		if i >= window {
+			acc = remove(acc, a.array[i-window])
+		}
+		if i >= window-1 {
+			result = append(result, acc)
+		}
+	}
+	return NewFrom(result)
+}
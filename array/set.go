@@ -0,0 +1,21 @@
+package array
+
+import "github.com/eicc27/Gophunc/set"
+
+// ToSet collects the elements of an array into a set,
+// dropping the duplicates.
+func ToSet[T comparable, U any](a *TypedArray[T, U]) set.Set[T] {
+	return set.NewSetFrom(a.array)
+}
+
+// FromSet creates a new TypedArray from the keys of a set.
+// As a set does not ensure the order of elements,
+// neither does the array.
+//
+// Example:
+//
+//	a := array.FromSet(array.ToSet(array.New(1, 2, 2, 3)))
+//	fmt.Println(a.Length()) // 3
+func FromSet[T comparable](s set.Set[T]) *TypedArray[T, any] {
+	return New(s.Keys()...)
+}
@@ -1,7 +1,5 @@
 package array
 
-import "github.com/eicc27/Gophunc/optional"
-
 func (t *TypedArray[T, U]) SimpleFilter(f func(T) bool) *TypedArray[T, U] {
 	return t.Filter(func(t T, _ int, _ []T) bool {
 		return f(t)
@@ -27,10 +25,13 @@ func (t *TypedArray[T, U]) SimpleForEach(f func(T)) *TypedArray[T, U] {
 }
 
 // SimpleMap cuts off the filter function for reducing returning type of function from Optional[U] to U.
+// As nothing is filtered, it maps into a preallocated array without wrapping values in Optional.
 func (t *TypedArray[T, U]) SimpleMap(f func(T) U) *TypedArray[U, any] {
-	return t.Map(func(t T, _ int, _ []T) *optional.Optional[U] {
-		return optional.Just(f(t))
-	})
+	result := make([]U, len(t.array))
+	for i, v := range t.array {
+		result[i] = f(v)
+	}
+	return NewFrom(result)
 }
 
 // SimpleReduce asserts that the array has at least one of element without returning a potential error with Result.
@@ -0,0 +1,84 @@
+package array
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/eicc27/Gophunc/internal/fields"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// FromRows scans every row of a query result into an array with scan,
+// and closes rows afterwards. It stops at the first error,
+// either returned by scan or by rows.
+//
+// Example:
+//
+//	rows, err := db.Query("SELECT id, name FROM users")
+//	...
+//	users := array.FromRows(rows, array.ScanStruct[User])
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) *R.Result[*TypedArray[T, any]] {
+	defer rows.Close()
+	result := New[T]()
+	for rows.Next() {
+		v, err := scan(rows)
+		if err != nil {
+			return R.Error[*TypedArray[T, any]](err)
+		}
+		result.array = append(result.array, v)
+	}
+	if err := rows.Err(); err != nil {
+		return R.Error[*TypedArray[T, any]](err)
+	}
+	return R.OK(result)
+}
+
+// ScanStruct scans the current row into a struct, to be used with FromRows.
+// Columns are matched to exported fields by the name in the "db" tag,
+// falling back to the field name, which is matched case-insensitively.
+// Unknown columns are ignored.
+//
+// Example:
+//
+//	type User struct {
+//		ID   int    `db:"id"`
+//		Name string `db:"name"`
+//	}
+func ScanStruct[T any](rows *sql.Rows) (T, error) {
+	var v T
+	value := reflect.ValueOf(&v).Elem()
+	if value.Kind() != reflect.Struct {
+		return v, fmt.Errorf("array: ScanStruct expects a struct, got %s", value.Type())
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return v, err
+	}
+	byName := fields.ByTag(value.Type(), "db")
+	targets := make([]any, len(columns))
+	for i, column := range columns {
+		if index, ok := fieldIndex(byName, column); ok {
+			targets[i] = value.Field(index).Addr().Interface()
+		} else {
+			targets[i] = new(any)
+		}
+	}
+	if err := rows.Scan(targets...); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+func fieldIndex(byName map[string]int, column string) (int, bool) {
+	if index, ok := byName[column]; ok {
+		return index, true
+	}
+	for name, index := range byName {
+		if strings.EqualFold(name, column) {
+			return index, true
+		}
+	}
+	return 0, false
+}
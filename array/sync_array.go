@@ -0,0 +1,140 @@
+package array
+
+import (
+	"slices"
+	"sync"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// SyncArray is a TypedArray safe for concurrent use by multiple goroutines,
+// like the ones launched by promise.All or ParMap.
+// It guards a TypedArray with a RWMutex, so reads run in parallel
+// while writes are exclusive.
+//
+// Callbacks passed to its methods are called while the lock is held,
+// so they must not call methods of the same array.
+//
+// Example:
+//
+//	results := array.NewSyncArray[string]()
+//	for _, url := range urls {
+//		go func(url string) { results.Push(fetch(url)) }(url)
+//	}
+type SyncArray[T, U any] struct {
+	mu sync.RWMutex
+	a  *TypedArray[T, U]
+}
+
+// NewSyncArray creates a new SyncArray from some items.
+func NewSyncArray[T any](items ...T) *SyncArray[T, any] {
+	return &SyncArray[T, any]{
+		a: NewFrom(slices.Clone(items)),
+	}
+}
+
+// NewSyncArrayFrom creates a new SyncArray from a copy of a TypedArray.
+func NewSyncArrayFrom[T, U any](a *TypedArray[T, U]) *SyncArray[T, U] {
+	return &SyncArray[T, U]{
+		a: NewMapperFrom[U](slices.Clone(a.array)),
+	}
+}
+
+// Push pushes items at the end of the array.
+func (s *SyncArray[T, U]) Push(items ...T) *SyncArray[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.a.Push(items...)
+	return s
+}
+
+// Pop pops an item at the end of the array.
+// If the array is empty, it returns a nothing optional.
+func (s *SyncArray[T, U]) Pop() *O.Optional[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.Pop()
+}
+
+// Shift shifts an item at the start of the array.
+// If the array is empty, it returns a nothing optional.
+func (s *SyncArray[T, U]) Shift() *O.Optional[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.Shift()
+}
+
+// Unshift inserts items at the start of the array.
+func (s *SyncArray[T, U]) Unshift(items ...T) *SyncArray[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.a.Unshift(items...)
+	return s
+}
+
+// AppendIfAbsent pushes v unless an element equal to it under eq exists,
+// as a single atomic step. It reports whether v is pushed.
+func (s *SyncArray[T, U]) AppendIfAbsent(v T, eq func(T, T) bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, x := range s.a.array {
+		if eq(x, v) {
+			return false
+		}
+	}
+	s.a.Push(v)
+	return true
+}
+
+// At indexes the array like the At method of TypedArray.
+func (s *SyncArray[T, U]) At(index int) *O.Optional[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.a.At(index)
+}
+
+// AtSafe indexes the array like the AtSafe method of TypedArray.
+func (s *SyncArray[T, U]) AtSafe(index int) *O.Optional[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.a.AtSafe(index)
+}
+
+// Length returns the length of the array.
+func (s *SyncArray[T, U]) Length() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.a.Length()
+}
+
+// ForEach applies f to each element while holding the read lock.
+func (s *SyncArray[T, U]) ForEach(f func(T, int, []T)) *SyncArray[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.a.ForEach(f)
+	return s
+}
+
+// Map maps the array like the Map method of TypedArray
+// while holding the read lock.
+func (s *SyncArray[T, U]) Map(f func(T, int, []T) *O.Optional[U]) *TypedArray[U, any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.a.Map(f)
+}
+
+// Filter gets all elements that satisfy f as a new SyncArray.
+func (s *SyncArray[T, U]) Filter(f func(T, int, []T) bool) *SyncArray[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncArray[T, U]{
+		a: s.a.Filter(f),
+	}
+}
+
+// ToTypedArray returns a snapshot of the array as a plain TypedArray.
+func (s *SyncArray[T, U]) ToTypedArray() *TypedArray[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return NewMapperFrom[U](slices.Clone(s.a.array))
+}
@@ -0,0 +1,208 @@
+package array
+
+import (
+	"sync"
+
+	O "github.com/eicc27/Gophunc/optional"
+	"github.com/eicc27/Gophunc/tuple"
+)
+
+// SyncTypedMap is a TypedMap safe for concurrent use by multiple goroutines,
+// like the ones launched by promise.All.
+// It guards a TypedMap with a RWMutex, so reads run in parallel
+// while writes are exclusive.
+//
+// Callbacks passed to its methods are called while the lock is held,
+// so they must not call methods of the same map.
+type SyncTypedMap[T comparable, U any] struct {
+	mu sync.RWMutex
+	m  *TypedMap[T, U]
+}
+
+// NewSyncTypedMap creates a new SyncTypedMap.
+func NewSyncTypedMap[T comparable, U any]() *SyncTypedMap[T, U] {
+	return &SyncTypedMap[T, U]{
+		m: NewTypedMap[T, U](),
+	}
+}
+
+// NewSyncTypedMapFrom creates a new SyncTypedMap from a copy of an existing map.
+func NewSyncTypedMapFrom[T comparable, U any](m map[T]U) *SyncTypedMap[T, U] {
+	return &SyncTypedMap[T, U]{
+		m: NewTypedMapFrom(m).Clone(),
+	}
+}
+
+// Get returns the value of the key.
+func (s *SyncTypedMap[T, U]) Get(key T) *O.Optional[U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+// GetOrDefault returns the value of the key,
+// or def if the key does not exist.
+func (s *SyncTypedMap[T, U]) GetOrDefault(key T, def U) U {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.GetOrDefault(key, def)
+}
+
+// GetOrSet returns the value of the key.
+// If the key does not exist, def is set as its value and returned.
+func (s *SyncTypedMap[T, U]) GetOrSet(key T, def U) U {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.GetOrSet(key, def)
+}
+
+// ComputeIfAbsent returns the value of the key.
+// If the key does not exist, f is called to compute the value,
+// which is then set and returned. f is called at most once per key.
+func (s *SyncTypedMap[T, U]) ComputeIfAbsent(key T, f func(T) U) U {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.ComputeIfAbsent(key, f)
+}
+
+// LoadOrStore returns the existing value of the key if present,
+// with loaded being true. Otherwise, it stores and returns value,
+// with loaded being false.
+func (s *SyncTypedMap[T, U]) LoadOrStore(key T, value U) (actual U, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m.m[key]; ok {
+		return v, true
+	}
+	s.m.m[key] = value
+	return value, false
+}
+
+// Set sets the value of the key.
+// If the key does not exist, it will be created.
+func (s *SyncTypedMap[T, U]) Set(key T, value U) *SyncTypedMap[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Set(key, value)
+	return s
+}
+
+// Update sets the value of the key to the result of f,
+// which is called with the current value while holding the lock,
+// so read-modify-write updates are atomic.
+// If f returns a nothing optional, the key is deleted.
+func (s *SyncTypedMap[T, U]) Update(key T, f func(*O.Optional[U]) *O.Optional[U]) *SyncTypedMap[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Update(key, f)
+	return s
+}
+
+// Merge sets all key-value pairs of other into the map, in place.
+// See the Merge of TypedMap for resolve.
+func (s *SyncTypedMap[T, U]) Merge(other *TypedMap[T, U], resolve func(T, U, U) U) *SyncTypedMap[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Merge(other, resolve)
+	return s
+}
+
+// Delete deletes the key and returns the deleted value.
+// If the key does not exist, it does nothing and returns a nothing optional.
+func (s *SyncTypedMap[T, U]) Delete(key T) *O.Optional[U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Delete(key)
+}
+
+// Has checks if the key exists.
+func (s *SyncTypedMap[T, U]) Has(key T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Has(key)
+}
+
+// Len returns the number of keys in the map.
+func (s *SyncTypedMap[T, U]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Len()
+}
+
+// Clear deletes all keys from the map.
+func (s *SyncTypedMap[T, U]) Clear() *SyncTypedMap[T, U] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+	return s
+}
+
+// Keys returns a snapshot of the keys of the map.
+func (s *SyncTypedMap[T, U]) Keys() *TypedArray[T, any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+// KeysSorted returns a snapshot of the keys of the map sorted by less.
+func (s *SyncTypedMap[T, U]) KeysSorted(less func(T, T) bool) *TypedArray[T, any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.KeysSorted(less)
+}
+
+// Values returns a snapshot of the values of the map.
+func (s *SyncTypedMap[T, U]) Values() *TypedArray[U, any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// Entries returns a snapshot of the key-value pairs of the map.
+func (s *SyncTypedMap[T, U]) Entries() *TypedArray[tuple.Pair[T, U], any] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Entries()
+}
+
+// ForEach applies f to each key-value pair while holding the read lock.
+func (s *SyncTypedMap[T, U]) ForEach(f func(T, U)) *SyncTypedMap[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.m.ForEach(f)
+	return s
+}
+
+// ForEachSorted applies f to each key-value pair in the order of keys
+// sorted by less, while holding the read lock.
+func (s *SyncTypedMap[T, U]) ForEachSorted(less func(T, T) bool, f func(T, U)) *SyncTypedMap[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.m.ForEachSorted(less, f)
+	return s
+}
+
+// Filter gets all key-value pairs that satisfy the predicate f as a new map.
+func (s *SyncTypedMap[T, U]) Filter(f func(T, U) bool) *SyncTypedMap[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncTypedMap[T, U]{
+		m: s.m.Filter(f),
+	}
+}
+
+// Clone returns a shallow copy of the map.
+func (s *SyncTypedMap[T, U]) Clone() *SyncTypedMap[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncTypedMap[T, U]{
+		m: s.m.Clone(),
+	}
+}
+
+// ToTypedMap returns a snapshot of the map as a plain TypedMap.
+func (s *SyncTypedMap[T, U]) ToTypedMap() *TypedMap[T, U] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Clone()
+}
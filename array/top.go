@@ -0,0 +1,42 @@
+package array
+
+import "container/heap"
+
+// TopN gets the n largest elements of the array according to less,
+// from the largest to the smallest.
+// It keeps a heap of at most n elements instead of sorting the whole array,
+// which takes O(len * log n).
+//
+// Example:
+//
+//	slowest := requests.TopN(10, func(a, b Request) bool {
+//		return a.Latency < b.Latency
+//	})
+func (r *TypedArray[T, U]) TopN(n int, less func(T, T) bool) *TypedArray[T, U] {
+	if n <= 0 {
+		return NewMapper[U, T]()
+	}
+	// a min-heap keeps the smallest of the current top n on its top
+	h := &lessHeap[T]{less: less}
+	for _, v := range r.array {
+		if h.Len() < n {
+			heap.Push(h, v)
+		} else if less(h.items[0], v) {
+			h.items[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+	result := make([]T, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(T)
+	}
+	return NewMapperFrom[U](result)
+}
+
+// BottomN gets the n smallest elements of the array according to less,
+// from the smallest to the largest. Like TopN, it takes O(len * log n).
+func (r *TypedArray[T, U]) BottomN(n int, less func(T, T) bool) *TypedArray[T, U] {
+	return r.TopN(n, func(a, b T) bool {
+		return less(b, a)
+	})
+}
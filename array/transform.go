@@ -0,0 +1,66 @@
+package array
+
+import O "github.com/eicc27/Gophunc/optional"
+
+// The Map family of TypedArray needs the output type U to be fixed
+// when the array is created, so every type-changing step needs WithType
+// before the next one. The top-level functions below infer the output type
+// from f instead, and accept an array of any U, so they chain without annotations.
+//
+// Example:
+//
+//	lengths := array.Map(array.Map(array.New("a", "bb"), strings.ToUpper), utf8.RuneCountInString)
+//	fmt.Println(lengths) // 1, 2
+
+// Map applies f to each element of an array.
+func Map[T, U, V any](a *TypedArray[T, V], f func(T) U) *TypedArray[U, any] {
+	result := make([]U, len(a.array))
+	for i, v := range a.array {
+		result[i] = f(v)
+	}
+	return NewFrom(result)
+}
+
+// MapIndex applies f to each element of an array and its index.
+func MapIndex[T, U, V any](a *TypedArray[T, V], f func(T, int) U) *TypedArray[U, any] {
+	result := make([]U, len(a.array))
+	for i, v := range a.array {
+		result[i] = f(v, i)
+	}
+	return NewFrom(result)
+}
+
+// FlatMap applies f to each element of an array,
+// and flattens the results into a single array.
+func FlatMap[T, U, V any](a *TypedArray[T, V], f func(T) []U) *TypedArray[U, any] {
+	result := make([]U, 0)
+	for _, v := range a.array {
+		result = append(result, f(v)...)
+	}
+	return NewFrom(result)
+}
+
+// FilterMap applies f to each element of an array,
+// keeping the values of the Optionals that are set.
+// Like the FilterMap method, f returns an Optional by value,
+// usually created by optional.Some or optional.None.
+//
+// Example:
+//
+//	ints := array.FilterMap(array.New("1", "x", "3"), func(s string) optional.Optional[int] {
+//		i, err := strconv.Atoi(s)
+//		if err != nil {
+//			return optional.None[int]()
+//		}
+//		return optional.Some(i)
+//	})
+//	fmt.Println(ints) // 1, 3
+func FilterMap[T, U, V any](a *TypedArray[T, V], f func(T) O.Optional[U]) *TypedArray[U, any] {
+	result := make([]U, 0)
+	for _, v := range a.array {
+		if r := f(v); r.IsSet() {
+			result = append(result, r.Value())
+		}
+	}
+	return NewFrom(result)
+}
@@ -0,0 +1,55 @@
+package array
+
+import O "github.com/eicc27/Gophunc/optional"
+
+// TransposeSlices turns the rows of a matrix into its columns.
+// A ragged matrix has as many columns as its longest row.
+// The cells missing from shorter rows take the value of fill if it is set.
+// Otherwise, including when fill is nil, they are skipped and the column
+// is left shorter. Skipping loses the alignment of a ragged matrix:
+// the i-th cell of a column no longer has to come from the i-th row,
+// so pass a fill when the row of each cell matters.
+//
+// Example:
+//
+//	array.TransposeSlices([][]int{{1, 2, 3}, {4}}, optional.Just(0))
+//	// [[1 4] [2 0] [3 0]]
+//	array.TransposeSlices([][]int{{1, 2, 3}, {4}}, optional.Nothing[int]())
+//	// [[1 4] [2] [3]]
+func TransposeSlices[T any](rows [][]T, fill *O.Optional[T]) [][]T {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	if fill == nil {
+		fill = O.Nothing[T]()
+	}
+	columns := make([][]T, width)
+	for j := range columns {
+		column := make([]T, 0, len(rows))
+		for _, row := range rows {
+			if j < len(row) {
+				column = append(column, row[j])
+			} else if fill.IsSet() {
+				column = append(column, fill.Value())
+			}
+		}
+		columns[j] = column
+	}
+	return columns
+}
+
+// Transpose is the TypedArray version of TransposeSlices,
+// turning an array of rows into an array of columns.
+func Transpose[T, U, V any](a *TypedArray[*TypedArray[T, U], V], fill *O.Optional[T]) *TypedArray[*TypedArray[T, any], any] {
+	rows := make([][]T, len(a.array))
+	for i, row := range a.array {
+		rows[i] = row.array
+	}
+	columns := TransposeSlices(rows, fill)
+	result := make([]*TypedArray[T, any], len(columns))
+	for j, column := range columns {
+		result[j] = NewFrom(column)
+	}
+	return NewFrom(result)
+}
@@ -0,0 +1,49 @@
+package array
+
+type uniqueOptions struct {
+	lastWins bool
+}
+
+// UniqueOption configures UniqueByKey.
+type UniqueOption func(*uniqueOptions)
+
+// LastWins keeps the last element of each key instead of the first one,
+// like the latest version of records carrying timestamps.
+func LastWins() UniqueOption {
+	return func(o *uniqueOptions) {
+		o.lastWins = true
+	}
+}
+
+// UniqueByKey drops the elements whose key returned by key has been seen,
+// keeping the first element of each key by default.
+// The kept elements stay in their relative order in the array.
+//
+// Example:
+//
+//	users := array.New(User{1, "a"}, User{2, "b"}, User{1, "c"})
+//	byID := func(u User) int { return u.ID }
+//	array.UniqueByKey(users, byID)                   // {1 a}, {2 b}
+//	array.UniqueByKey(users, byID, array.LastWins()) // {2 b}, {1 c}
+func UniqueByKey[K comparable, T, U any](a *TypedArray[T, U], key func(T) K, opts ...UniqueOption) *TypedArray[T, U] {
+	var o uniqueOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	// the index of the kept element of each key
+	kept := make(map[K]int, len(a.array))
+	keys := make([]K, len(a.array))
+	for i, v := range a.array {
+		keys[i] = key(v)
+		if _, ok := kept[keys[i]]; !ok || o.lastWins {
+			kept[keys[i]] = i
+		}
+	}
+	result := make([]T, 0, len(kept))
+	for i, v := range a.array {
+		if kept[keys[i]] == i {
+			result = append(result, v)
+		}
+	}
+	return NewMapperFrom[U](result)
+}
@@ -0,0 +1,43 @@
+package array
+
+import "github.com/eicc27/Gophunc/tuple"
+
+// ZipWith combines two arrays element-wise with f.
+// The result is as long as the shorter array.
+func ZipWith[A, B, C, U, V any](a *TypedArray[A, U], b *TypedArray[B, V], f func(A, B) C) *TypedArray[C, any] {
+	n := min(len(a.array), len(b.array))
+	result := make([]C, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a.array[i], b.array[i])
+	}
+	return NewFrom(result)
+}
+
+// Zip pairs up two arrays element-wise.
+// The result is as long as the shorter array.
+func Zip[A, B, U, V any](a *TypedArray[A, U], b *TypedArray[B, V]) *TypedArray[tuple.Pair[A, B], any] {
+	return ZipWith(a, b, tuple.NewPair[A, B])
+}
+
+// ZipWith3 combines three arrays element-wise with f.
+// The result is as long as the shortest array.
+//
+// Example:
+//
+//	totals := array.ZipWith3(prices, quantities, discounts,
+//		func(p float64, q int, d float64) float64 { return p * float64(q) * (1 - d) },
+//	)
+func ZipWith3[A, B, C, D, U, V, W any](a *TypedArray[A, U], b *TypedArray[B, V], c *TypedArray[C, W], f func(A, B, C) D) *TypedArray[D, any] {
+	n := min(len(a.array), len(b.array), len(c.array))
+	result := make([]D, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a.array[i], b.array[i], c.array[i])
+	}
+	return NewFrom(result)
+}
+
+// Zip3 groups three arrays element-wise into triples.
+// The result is as long as the shortest array.
+func Zip3[A, B, C, U, V, W any](a *TypedArray[A, U], b *TypedArray[B, V], c *TypedArray[C, W]) *TypedArray[tuple.Triple[A, B, C], any] {
+	return ZipWith3(a, b, c, tuple.NewTriple[A, B, C])
+}
@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// LRU is a cache keeping at most a fixed number of entries.
+// When it is full, the least recently used entry is evicted.
+// It is safe for concurrent use.
+//
+// Example:
+//
+//	users := cache.New[int, User](1000).OnEvict(func(id int, _ User) {
+//		log.Println("evicted", id)
+//	})
+//	u := users.GetOrComputeResult(id, func() *result.Result[User] {
+//		return fetchUser(id)
+//	})
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[K]*list.Element
+	order    *list.List
+	onEvict  func(K, V)
+	ttl      time.Duration
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// New creates a new LRU holding at most capacity entries.
+// A non-positive capacity is set to 1.
+func New[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: max(capacity, 1),
+		entries:  make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// OnEvict sets f to be called with every entry evicted for capacity.
+// f is called without holding the lock, so it could use the cache.
+func (c *LRU[K, V]) OnEvict(f func(K, V)) *LRU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onEvict = f
+	return c
+}
+
+// ExpireAfter makes entries set from now on expire d after they are set.
+// An expired entry is dropped when it is next read, without calling
+// the eviction callback.
+// A non-positive d means entries never expire, which is the default.
+func (c *LRU[K, V]) ExpireAfter(d time.Duration) *LRU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = d
+	return c
+}
+
+// Get returns the value of the key and marks it as recently used.
+// If the key does not exist or has expired, it returns a nothing optional.
+func (c *LRU[K, V]) Get(key K) *O.Optional[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return O.Nothing[V]()
+	}
+	en := e.Value.(*entry[K, V])
+	if !en.expires.IsZero() && time.Now().After(en.expires) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return O.Nothing[V]()
+	}
+	c.order.MoveToFront(e)
+	return O.Just(en.value)
+}
+
+// Set sets the value of the key, evicting the least recently used entry
+// if the cache is full.
+func (c *LRU[K, V]) Set(key K, value V) *LRU[K, V] {
+	c.mu.Lock()
+	evicted := c.set(key, value)
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	if evicted != nil && onEvict != nil {
+		onEvict(evicted.key, evicted.value)
+	}
+	return c
+}
+
+// Delete deletes the key and returns the deleted value.
+// If the key does not exist, it returns a nothing optional.
+// The eviction callback is not called.
+func (c *LRU[K, V]) Delete(key K) *O.Optional[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return O.Nothing[V]()
+	}
+	c.order.Remove(e)
+	delete(c.entries, key)
+	return O.Just(e.Value.(*entry[K, V]).value)
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// GetOrCompute returns the value of the key.
+// If the key does not exist, f is called to compute the value,
+// which is then cached and returned.
+// f is called without holding the lock, so concurrent calls
+// with the same missing key may call f more than once.
+func (c *LRU[K, V]) GetOrCompute(key K, f func() V) V {
+	if v := c.Get(key); v.IsSet() {
+		return v.Value()
+	}
+	v := f()
+	c.Set(key, v)
+	return v
+}
+
+// GetOrComputeResult is the Result-aware version of GetOrCompute.
+// Only a successful result is cached, so a failed computation
+// is retried next time.
+func (c *LRU[K, V]) GetOrComputeResult(key K, f func() *R.Result[V]) *R.Result[V] {
+	if v := c.Get(key); v.IsSet() {
+		return R.OK(v.Value())
+	}
+	r := f()
+	r.IfOKThen(func(v V) {
+		c.Set(key, v)
+	})
+	return r
+}
+
+// set must be called with the lock held.
+// It returns the evicted entry, if any.
+func (c *LRU[K, V]) set(key K, value V) *entry[K, V] {
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = time.Now().Add(c.ttl)
+	}
+	if e, ok := c.entries[key]; ok {
+		en := e.Value.(*entry[K, V])
+		en.value, en.expires = value, expires
+		c.order.MoveToFront(e)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expires: expires})
+	if c.order.Len() <= c.capacity {
+		return nil
+	}
+	oldest := c.order.Back()
+	c.order.Remove(oldest)
+	evicted := oldest.Value.(*entry[K, V])
+	delete(c.entries, evicted.key)
+	return evicted
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+func TestLRU(t *testing.T) {
+	evicted := make([]int, 0)
+	c := New[int, string](2).OnEvict(func(k int, _ string) {
+		evicted = append(evicted, k)
+	})
+	c.Set(1, "a").Set(2, "b")
+	// reading 1 makes 2 the least recently used
+	c.Get(1)
+	c.Set(3, "c")
+	if c.Get(2).IsSet() || !c.Get(1).IsSet() || !c.Get(3).IsSet() {
+		t.Error("the least recently used entry should be evicted")
+	}
+	if len(evicted) != 1 || evicted[0] != 2 {
+		t.Errorf("evicted %v, want [2]", evicted)
+	}
+	if d := c.Delete(1); !d.IsSet() || d.Value() != "a" || c.Len() != 1 {
+		t.Errorf("Delete = %v, Len = %d", d, c.Len())
+	}
+	if c.Delete(1).IsSet() {
+		t.Error("deleting a missing key should give nothing")
+	}
+}
+
+func TestLRUExpireAfter(t *testing.T) {
+	c := New[string, int](10).ExpireAfter(20 * time.Millisecond)
+	c.Set("a", 1)
+	if !c.Get("a").IsSet() {
+		t.Fatal("entry expired too early")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if c.Get("a").IsSet() || c.Len() != 0 {
+		t.Error("an expired entry should be dropped")
+	}
+}
+
+func TestLRUGetOrComputeResult(t *testing.T) {
+	c := New[string, int](10)
+	calls := 0
+	fail := func() *R.Result[int] {
+		calls++
+		return R.Error[int](errors.New("failed"))
+	}
+	c.GetOrComputeResult("a", fail)
+	c.GetOrComputeResult("a", fail)
+	if calls != 2 {
+		t.Errorf("a failed computation was cached")
+	}
+	ok := func() *R.Result[int] {
+		calls++
+		return R.OK(1)
+	}
+	c.GetOrComputeResult("a", ok)
+	c.GetOrComputeResult("a", ok)
+	if calls != 3 {
+		t.Errorf("a successful computation was not cached")
+	}
+}
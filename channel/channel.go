@@ -0,0 +1,165 @@
+package channel
+
+import (
+	"sync"
+	"time"
+)
+
+// Every adapter in this package starts a goroutine reading from its input
+// and closes its output once the input is closed and drained.
+// Outputs must be drained as well, or the goroutine blocks forever.
+
+// Map applies f to each value received from in.
+//
+// Example:
+//
+//	lengths := channel.Map(lines, func(s string) int { return len(s) })
+func Map[T, U any](in <-chan T, f func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- f(v)
+		}
+	}()
+	return out
+}
+
+// Filter keeps the values received from in that satisfy f.
+func Filter[T any](in <-chan T, f func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range in {
+			if f(v) {
+				out <- v
+			}
+		}
+	}()
+	return out
+}
+
+// Merge fans in the values of several channels into a single one.
+// The order across channels is not specified.
+// The output is closed after all inputs are closed.
+func Merge[T any](ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range ins {
+		wg.Add(1)
+		go func(in <-chan T) {
+			defer wg.Done()
+			for v := range in {
+				out <- v
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values of in over n channels.
+// Each value is sent to exactly one of them, whichever is ready first,
+// so it suits spreading work among n workers.
+func FanOut[T any](in <-chan T, n int) []<-chan T {
+	n = max(n, 1)
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for v := range in {
+				out <- v
+			}
+		}()
+	}
+	return outs
+}
+
+// Split sends the values of in satisfying f to matched,
+// and the others to unmatched. Both of them must be drained.
+func Split[T any](in <-chan T, f func(T) bool) (matched <-chan T, unmatched <-chan T) {
+	yes, no := make(chan T), make(chan T)
+	go func() {
+		defer close(yes)
+		defer close(no)
+		for v := range in {
+			if f(v) {
+				yes <- v
+			} else {
+				no <- v
+			}
+		}
+	}()
+	return yes, no
+}
+
+// Batch groups the values of in into slices of at most n values.
+// A batch is sent once it is full, or once timeout has passed
+// since its first value, whichever comes first.
+// A non-positive timeout means batches are only sent when full.
+// The last batch is sent when in is closed.
+//
+// Example:
+//
+//	for rows := range channel.Batch(events, 100, time.Second) {
+//		db.InsertMany(rows)
+//	}
+func Batch[T any](in <-chan T, n int, timeout time.Duration) <-chan []T {
+	n = max(n, 1)
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, n)
+		var timer *time.Timer
+		var expired <-chan time.Time
+		flush := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, expired = nil, nil
+			}
+			if len(batch) > 0 {
+				out <- batch
+				batch = make([]T, 0, n)
+			}
+		}
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && timeout > 0 {
+					timer = time.NewTimer(timeout)
+					expired = timer.C
+				}
+				if len(batch) >= n {
+					flush()
+				}
+			case <-expired:
+				timer, expired = nil, nil
+				flush()
+			}
+		}
+	}()
+	return out
+}
+
+// Buffer relays the values of in through a channel with a buffer of size,
+// letting a fast producer run ahead of a slow consumer.
+func Buffer[T any](in <-chan T, size int) <-chan T {
+	out := make(chan T, max(size, 0))
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- v
+		}
+	}()
+	return out
+}
@@ -0,0 +1,50 @@
+package cmp
+
+import stdcmp "cmp"
+
+// Comparator compares two values, returning a negative number if a < b,
+// zero if a == b, and a positive number if a > b, as slices.SortFunc expects.
+//
+// Example:
+//
+//	byAgeThenName := cmp.By(func(u User) int { return u.Age }).
+//		ThenBy(cmp.By(func(u User) string { return u.Name }))
+//	slices.SortFunc(users, byAgeThenName)
+type Comparator[T any] func(a, b T) int
+
+// Natural compares ordered values by their natural order.
+func Natural[T stdcmp.Ordered]() Comparator[T] {
+	return stdcmp.Compare[T]
+}
+
+// By compares values by the keys extracted by key.
+func By[T any, K stdcmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return stdcmp.Compare(key(a), key(b))
+	}
+}
+
+// Reversed reverses the order of a Comparator.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenBy breaks the ties of a Comparator with next.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Less converts a Comparator to a less function,
+// as used by sort.Slice and heap.New.
+func (c Comparator[T]) Less() func(a, b T) bool {
+	return func(a, b T) bool {
+		return c(a, b) < 0
+	}
+}
@@ -0,0 +1,123 @@
+package deque
+
+import (
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// Pushing and popping at both ends take amortized O(1),
+// unlike Shift and Unshift of TypedArray which take O(n).
+type Deque[T any] struct {
+	items []T
+	head  int
+	size  int
+}
+
+// New creates a new Deque with some items, from front to back.
+func New[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	return d.PushBack(items...)
+}
+
+// PushBack adds items at the back of the Deque, in order.
+func (d *Deque[T]) PushBack(items ...T) *Deque[T] {
+	for _, v := range items {
+		if d.size == len(d.items) {
+			d.grow()
+		}
+		d.items[d.index(d.size)] = v
+		d.size++
+	}
+	return d
+}
+
+// PushFront adds items at the front of the Deque, one by one,
+// so the last item ends at the front.
+func (d *Deque[T]) PushFront(items ...T) *Deque[T] {
+	for _, v := range items {
+		if d.size == len(d.items) {
+			d.grow()
+		}
+		d.head = (d.head - 1 + len(d.items)) % len(d.items)
+		d.items[d.head] = v
+		d.size++
+	}
+	return d
+}
+
+// PopFront removes the item at the front of the Deque and returns it.
+// If the Deque is empty, it returns a nothing optional.
+func (d *Deque[T]) PopFront() *O.Optional[T] {
+	if d.size == 0 {
+		return O.Nothing[T]()
+	}
+	v := d.items[d.head]
+	var zero T
+	d.items[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return O.Just(v)
+}
+
+// PopBack removes the item at the back of the Deque and returns it.
+// If the Deque is empty, it returns a nothing optional.
+func (d *Deque[T]) PopBack() *O.Optional[T] {
+	if d.size == 0 {
+		return O.Nothing[T]()
+	}
+	i := d.index(d.size - 1)
+	v := d.items[i]
+	var zero T
+	d.items[i] = zero
+	d.size--
+	return O.Just(v)
+}
+
+// Front returns the item at the front of the Deque without removing it.
+func (d *Deque[T]) Front() *O.Optional[T] {
+	return d.At(0)
+}
+
+// Back returns the item at the back of the Deque without removing it.
+func (d *Deque[T]) Back() *O.Optional[T] {
+	return d.At(d.size - 1)
+}
+
+// At returns the item at index i counted from the front.
+// If i is out of range, it returns a nothing optional.
+func (d *Deque[T]) At(i int) *O.Optional[T] {
+	if i < 0 || i >= d.size {
+		return O.Nothing[T]()
+	}
+	return O.Just(d.items[d.index(i)])
+}
+
+// Len returns the number of items in the Deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// ToTypedArray copies the items into a TypedArray, from front to back.
+func (d *Deque[T]) ToTypedArray() *A.TypedArray[T, any] {
+	items := make([]T, d.size)
+	for i := range items {
+		items[i] = d.items[d.index(i)]
+	}
+	return A.New(items...)
+}
+
+// index maps the i-th item from the front to its position in the buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.items)
+}
+
+// grow doubles the capacity, moving the items to the start of the buffer.
+func (d *Deque[T]) grow() {
+	items := make([]T, max(len(d.items)*2, 8))
+	for i := 0; i < d.size; i++ {
+		items[i] = d.items[d.index(i)]
+	}
+	d.items = items
+	d.head = 0
+}
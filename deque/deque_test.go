@@ -0,0 +1,55 @@
+package deque
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	d := New(2, 3)
+	d.PushFront(1, 0).PushBack(4, 5)
+	if got := d.ToTypedArray().ToArray(); !slices.Equal(got, []int{0, 1, 2, 3, 4, 5}) {
+		t.Fatalf("Deque = %v", got)
+	}
+	if d.Front().Value() != 0 || d.Back().Value() != 5 || d.At(3).Value() != 3 {
+		t.Error("Front, Back or At returned a wrong item")
+	}
+	if d.At(-1).IsSet() || d.At(6).IsSet() {
+		t.Error("At out of range should be nothing")
+	}
+	if d.PopFront().Value() != 0 || d.PopBack().Value() != 5 || d.Len() != 4 {
+		t.Error("PopFront or PopBack returned a wrong item")
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	d := New[int]()
+	if d.PopFront().IsSet() || d.PopBack().IsSet() || d.Front().IsSet() || d.Back().IsSet() {
+		t.Error("an empty deque should give nothing")
+	}
+}
+
+// TestDequeWrap pushes and pops at both ends across growths,
+// checking against a slice.
+func TestDequeWrap(t *testing.T) {
+	d := New[int]()
+	want := make([]int, 0)
+	for i := 0; i < 1000; i++ {
+		switch i % 5 {
+		case 0, 1:
+			d.PushBack(i)
+			want = append(want, i)
+		case 2:
+			d.PushFront(i)
+			want = append([]int{i}, want...)
+		case 3:
+			if got := d.PopFront().Value(); got != want[0] {
+				t.Fatalf("PopFront = %d, want %d", got, want[0])
+			}
+			want = want[1:]
+		}
+	}
+	if got := d.ToTypedArray().ToArray(); !slices.Equal(got, want) {
+		t.Errorf("Deque = %v, want %v", got, want)
+	}
+}
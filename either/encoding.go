@@ -0,0 +1,36 @@
+package either
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Either[L, R] is encoded by gob through its exported fields,
+// whose Optional values know how to encode themselves.
+// eitherGob has the same fields without the methods below,
+// which would otherwise be called by gob recursively.
+type eitherGob[L any, R any] struct {
+	Left  O.Optional[L]
+	Right O.Optional[R]
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the gob encoding.
+func (e Either[L, R]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(eitherGob[L, R](e)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler with the gob encoding.
+func (e *Either[L, R]) UnmarshalBinary(data []byte) error {
+	var v eitherGob[L, R]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+		return err
+	}
+	*e = Either[L, R](v)
+	return nil
+}
@@ -0,0 +1,119 @@
+package events
+
+import (
+	"sync"
+
+	P "github.com/eicc27/Gophunc/promise"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Emitter delivers events of type T to its subscribers.
+// It is safe for concurrent use.
+//
+// Example:
+//
+//	e := events.New[string]()
+//	unsubscribe := e.Subscribe(func(s string) { fmt.Println(s) })
+//	e.Emit("hello")
+//	unsubscribe()
+type Emitter[T any] struct {
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers []subscriber[T]
+}
+
+type subscriber[T any] struct {
+	id      uint64
+	handler func(T)
+}
+
+// New creates a new Emitter without subscribers.
+func New[T any]() *Emitter[T] {
+	return &Emitter[T]{}
+}
+
+// Subscribe adds a handler called for every emitted event,
+// and returns a function removing it.
+// Calling the returned function more than once has no effect.
+func (e *Emitter[T]) Subscribe(handler func(T)) func() {
+	return e.add(func(uint64) func(T) { return handler })
+}
+
+// Once adds a handler called only for the next emitted event.
+// It returns a function removing the handler before it is called.
+func (e *Emitter[T]) Once(handler func(T)) func() {
+	return e.add(func(id uint64) func(T) {
+		var once sync.Once
+		return func(t T) {
+			once.Do(func() {
+				e.unsubscribe(id)
+				handler(t)
+			})
+		}
+	})
+}
+
+// add subscribes the handler built for a new subscriber id.
+// The handler is built under the lock, before any Emit could call it.
+func (e *Emitter[T]) add(build func(id uint64) func(T)) func() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	id := e.nextID
+	e.nextID++
+	e.subscribers = append(e.subscribers, subscriber[T]{id: id, handler: build(id)})
+	return func() {
+		e.unsubscribe(id)
+	}
+}
+
+// Emit calls every handler with the event, in the order they subscribed,
+// and returns after all of them return.
+// Handlers subscribed or removed during Emit take effect from the next event.
+func (e *Emitter[T]) Emit(event T) *Emitter[T] {
+	for _, s := range e.snapshot() {
+		s.handler(event)
+	}
+	return e
+}
+
+// EmitAsync calls every handler with the event concurrently, each in a promise.
+// The returned promise settles once all handlers return,
+// and fails if any of them panics.
+func (e *Emitter[T]) EmitAsync(event T) *P.Promise[struct{}] {
+	subscribers := e.snapshot()
+	return P.New(func() *R.Result[struct{}] {
+		var g P.Group
+		for _, s := range subscribers {
+			handler := s.handler
+			g.Go(func() error {
+				handler(event)
+				return nil
+			})
+		}
+		return g.Wait()
+	})
+}
+
+// Len returns the number of subscribers.
+func (e *Emitter[T]) Len() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.subscribers)
+}
+
+func (e *Emitter[T]) snapshot() []subscriber[T] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]subscriber[T](nil), e.subscribers...)
+}
+
+func (e *Emitter[T]) unsubscribe(id uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, s := range e.subscribers {
+		if s.id == id {
+			e.subscribers = append(e.subscribers[:i:i], e.subscribers[i+1:]...)
+			return
+		}
+	}
+}
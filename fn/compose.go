@@ -0,0 +1,62 @@
+package fn
+
+// Pipe2 chains two functions from left to right:
+// the result is x -> g(f(x)).
+//
+// Example:
+//
+//	parse := fn.Pipe2(strings.TrimSpace, strconv.Quote)
+//	fmt.Println(parse("  a ")) // "a"
+func Pipe2[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// Pipe3 chains three functions from left to right.
+func Pipe3[A, B, C, D any](f func(A) B, g func(B) C, h func(C) D) func(A) D {
+	return func(a A) D {
+		return h(g(f(a)))
+	}
+}
+
+// Pipe4 chains four functions from left to right.
+func Pipe4[A, B, C, D, E any](f func(A) B, g func(B) C, h func(C) D, i func(D) E) func(A) E {
+	return func(a A) E {
+		return i(h(g(f(a))))
+	}
+}
+
+// Pipe5 chains five functions from left to right.
+func Pipe5[A, B, C, D, E, F any](f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F) func(A) F {
+	return func(a A) F {
+		return j(i(h(g(f(a)))))
+	}
+}
+
+// Compose2 composes two functions from right to left, as in mathematics:
+// the result is x -> f(g(x)).
+func Compose2[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return Pipe2(g, f)
+}
+
+// Compose3 composes three functions from right to left.
+func Compose3[A, B, C, D any](f func(C) D, g func(B) C, h func(A) B) func(A) D {
+	return Pipe3(h, g, f)
+}
+
+// Compose4 composes four functions from right to left.
+func Compose4[A, B, C, D, E any](f func(D) E, g func(C) D, h func(B) C, i func(A) B) func(A) E {
+	return Pipe4(i, h, g, f)
+}
+
+// Compose5 composes five functions from right to left.
+func Compose5[A, B, C, D, E, F any](f func(E) F, g func(D) E, h func(C) D, i func(B) C, j func(A) B) func(A) F {
+	return Pipe5(j, i, h, g, f)
+}
+
+// Identity returns its argument as is.
+// It is the neutral element of composition.
+func Identity[T any](t T) T {
+	return t
+}
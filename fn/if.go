@@ -0,0 +1,24 @@
+package fn
+
+// If returns then if cond is true, and els otherwise.
+// Both values are evaluated before the call, so use IfFunc
+// if any of them is expensive or has side effects.
+//
+// Example:
+//
+//	label := fn.If(n == 1, "item", "items")
+func If[T any](cond bool, then T, els T) T {
+	if cond {
+		return then
+	}
+	return els
+}
+
+// IfFunc is the lazy version of If.
+// Only the function of the taken branch is called.
+func IfFunc[T any](cond bool, then func() T, els func() T) T {
+	if cond {
+		return then()
+	}
+	return els()
+}
@@ -0,0 +1,75 @@
+package fn
+
+import (
+	"math"
+	"time"
+
+	"github.com/eicc27/Gophunc/cache"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+type memoizeOptions struct {
+	capacity int
+	ttl      time.Duration
+}
+
+// MemoizeOption configures the cache of Memoize.
+type MemoizeOption func(*memoizeOptions)
+
+// WithCapacity limits the cache to n entries.
+// When it is full, the least recently used entry is evicted.
+// A non-positive n means no limit, which is the default.
+func WithCapacity(n int) MemoizeOption {
+	return func(o *memoizeOptions) {
+		o.capacity = n
+	}
+}
+
+// WithTTL makes cached entries expire after d.
+// A non-positive d means entries never expire, which is the default.
+func WithTTL(d time.Duration) MemoizeOption {
+	return func(o *memoizeOptions) {
+		o.ttl = d
+	}
+}
+
+// Memoize returns a cached version of f, which calls f only once
+// per argument until the entry is evicted or expires.
+// The returned function is safe for concurrent use.
+// Concurrent calls with the same uncached argument may call f more than once.
+//
+// Example:
+//
+//	fib := fn.Memoize(slowFib, fn.WithCapacity(1000), fn.WithTTL(time.Minute))
+func Memoize[K comparable, V any](f func(K) V, opts ...MemoizeOption) func(K) V {
+	c := newMemoCache[K, V](opts)
+	return func(k K) V {
+		return c.GetOrCompute(k, func() V {
+			return f(k)
+		})
+	}
+}
+
+// MemoizeResult is the Result-aware version of Memoize.
+// Only successful results are cached, so a failed call is retried next time.
+func MemoizeResult[K comparable, V any](f func(K) *R.Result[V], opts ...MemoizeOption) func(K) *R.Result[V] {
+	c := newMemoCache[K, V](opts)
+	return func(k K) *R.Result[V] {
+		return c.GetOrComputeResult(k, func() *R.Result[V] {
+			return f(k)
+		})
+	}
+}
+
+// newMemoCache builds the cache.LRU behind Memoize from its options.
+func newMemoCache[K comparable, V any](opts []MemoizeOption) *cache.LRU[K, V] {
+	var o memoizeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	capacity := o.capacity
+	if capacity <= 0 {
+		capacity = math.MaxInt
+	}
+	return cache.New[K, V](capacity).ExpireAfter(o.ttl)
+}
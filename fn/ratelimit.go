@@ -0,0 +1,66 @@
+package fn
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows at most n events per duration on average,
+// with bursts of up to n events. It is a token bucket
+// refilled at the rate of n tokens per duration.
+// It is safe for concurrent use, and waiting callers are served in order.
+type Limiter struct {
+	mu       sync.Mutex
+	burst    float64
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+// NewLimiter creates a Limiter allowing n events per duration.
+// A non-positive n is set to 1.
+func NewLimiter(n int, per time.Duration) *Limiter {
+	n = max(n, 1)
+	return &Limiter{
+		burst:    float64(n),
+		interval: per / time.Duration(n),
+		tokens:   float64(n),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until an event is allowed.
+func (l *Limiter) Wait() {
+	l.mu.Lock()
+	now := time.Now()
+	if l.interval > 0 {
+		l.tokens = min(l.burst, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+	} else {
+		l.tokens = l.burst
+	}
+	l.last = now
+	// takes the token in advance, so later callers wait after this one
+	l.tokens--
+	wait := time.Duration(-l.tokens * float64(l.interval))
+	l.mu.Unlock()
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// RateLimit wraps f so that it is called at most n times per duration,
+// blocking the callers exceeding the rate.
+// It works for promise factories as well, capping how fast promises start.
+//
+// Example:
+//
+//	fetch := fn.RateLimit(10, time.Second, func(url string) *promise.Promise[[]byte] {
+//		return promise.New(download(url))
+//	})
+func RateLimit[A, B any](n int, per time.Duration, f func(A) B) func(A) B {
+	l := NewLimiter(n, per)
+	return func(a A) B {
+		l.Wait()
+		return f(a)
+	}
+}
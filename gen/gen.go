@@ -0,0 +1,162 @@
+// Package gen generates random values, including Gophunc containers,
+// for property-based tests.
+//
+// Example:
+//
+//	ints := gen.Array(gen.Int(-100, 100))
+//	gen.Check(t, ints, gen.ShrinkArray(gen.ShrinkInt), func(a *array.TypedArray[int, any]) bool {
+//		return a.SimpleFilter(isEven).Length() <= a.Length()
+//	}, gen.Options{})
+package gen
+
+import (
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+	"github.com/eicc27/Gophunc/set"
+)
+
+// Gen generates a random value from r.
+// size bounds the size of the value, like the length of an array,
+// and grows across the runs of Check.
+type Gen[T any] func(r *rand.Rand, size int) T
+
+// Sample generates a value with a fixed seed, so the same seed
+// always gives the same value. It suits fuzz targets taking a seed.
+//
+// Example:
+//
+//	f.Fuzz(func(t *testing.T, seed int64) {
+//		a := gen.Sample(gen.Array(gen.Int(0, 9)), seed, 50)
+//		...
+//	})
+func Sample[T any](g Gen[T], seed int64, size int) T {
+	return g(rand.New(rand.NewSource(seed)), size)
+}
+
+// QuickConfig creates a testing/quick config generating
+// the only argument of a property with g.
+//
+// Example:
+//
+//	quick.Check(func(s set.Set[int]) bool { ... }, gen.QuickConfig(gen.Set(gen.Int(0, 9)), 20))
+func QuickConfig[T any](g Gen[T], size int) *quick.Config {
+	return &quick.Config{
+		Values: func(args []reflect.Value, r *rand.Rand) {
+			args[0] = reflect.ValueOf(g(r, size))
+		},
+	}
+}
+
+// Const always generates the same value.
+func Const[T any](value T) Gen[T] {
+	return func(*rand.Rand, int) T {
+		return value
+	}
+}
+
+// OneOf generates one of some items with equal probability.
+// It panics if no item is given.
+func OneOf[T any](items ...T) Gen[T] {
+	if len(items) == 0 {
+		panic("gen: OneOf needs at least one item")
+	}
+	return func(r *rand.Rand, _ int) T {
+		return items[r.Intn(len(items))]
+	}
+}
+
+// Int generates an int in [min, max].
+func Int(min int, max int) Gen[int] {
+	if max < min {
+		min, max = max, min
+	}
+	return func(r *rand.Rand, _ int) int {
+		return min + r.Intn(max-min+1)
+	}
+}
+
+// Float64 generates a float64 in [min, max).
+func Float64(min float64, max float64) Gen[float64] {
+	return func(r *rand.Rand, _ int) float64 {
+		return min + r.Float64()*(max-min)
+	}
+}
+
+// Bool generates true or false with equal probability.
+func Bool() Gen[bool] {
+	return func(r *rand.Rand, _ int) bool {
+		return r.Intn(2) == 1
+	}
+}
+
+// String generates a string of at most size runes drawn from alphabet.
+// An empty alphabet means lowercase ASCII letters.
+func String(alphabet string) Gen[string] {
+	runes := []rune(alphabet)
+	if len(runes) == 0 {
+		runes = []rune("abcdefghijklmnopqrstuvwxyz")
+	}
+	return func(r *rand.Rand, size int) string {
+		s := make([]rune, r.Intn(size+1))
+		for i := range s {
+			s[i] = runes[r.Intn(len(runes))]
+		}
+		return string(s)
+	}
+}
+
+// Map applies f to the values generated by g.
+func Map[T, U any](g Gen[T], f func(T) U) Gen[U] {
+	return func(r *rand.Rand, size int) U {
+		return f(g(r, size))
+	}
+}
+
+// Array generates a TypedArray of at most size elements generated by elem.
+func Array[T any](elem Gen[T]) Gen[*A.TypedArray[T, any]] {
+	return func(r *rand.Rand, size int) *A.TypedArray[T, any] {
+		items := make([]T, r.Intn(size+1))
+		for i := range items {
+			items[i] = elem(r, size)
+		}
+		return A.NewFrom(items)
+	}
+}
+
+// TypedMap generates a TypedMap of at most size entries,
+// whose keys and values are generated by key and value.
+func TypedMap[K comparable, V any](key Gen[K], value Gen[V]) Gen[*A.TypedMap[K, V]] {
+	return func(r *rand.Rand, size int) *A.TypedMap[K, V] {
+		m := A.NewTypedMap[K, V]()
+		for n := r.Intn(size + 1); n > 0; n-- {
+			m.Set(key(r, size), value(r, size))
+		}
+		return m
+	}
+}
+
+// Set generates a Set of at most size elements generated by elem.
+func Set[T comparable](elem Gen[T]) Gen[set.Set[T]] {
+	return func(r *rand.Rand, size int) set.Set[T] {
+		s := set.New[T]()
+		for n := r.Intn(size + 1); n > 0; n-- {
+			s.Add(elem(r, size))
+		}
+		return s
+	}
+}
+
+// Optional generates a nothing optional for one in four values,
+// and a value generated by elem otherwise.
+func Optional[T any](elem Gen[T]) Gen[*O.Optional[T]] {
+	return func(r *rand.Rand, size int) *O.Optional[T] {
+		if r.Intn(4) == 0 {
+			return O.Nothing[T]()
+		}
+		return O.Just(elem(r, size))
+	}
+}
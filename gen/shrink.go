@@ -0,0 +1,176 @@
+package gen
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+	"github.com/eicc27/Gophunc/set"
+)
+
+// Shrinker returns simpler candidates of a value, simplest first,
+// which Check tries in order to find a minimal failing value.
+type Shrinker[T any] func(T) []T
+
+// ShrinkInt shrinks an int towards 0.
+func ShrinkInt(n int) []int {
+	if n == 0 {
+		return nil
+	}
+	candidates := []int{0}
+	if n/2 != 0 {
+		candidates = append(candidates, n/2)
+	}
+	if n < 0 {
+		candidates = append(candidates, -n, n+1)
+	} else if n > 1 {
+		candidates = append(candidates, n-1)
+	}
+	return candidates
+}
+
+// ShrinkArray shrinks a TypedArray by dropping its halves and
+// its elements one by one, then by shrinking its elements with elem.
+// elem could be nil to keep elements as they are.
+func ShrinkArray[T any](elem Shrinker[T]) Shrinker[*A.TypedArray[T, any]] {
+	return func(a *A.TypedArray[T, any]) []*A.TypedArray[T, any] {
+		items := a.ToArray()
+		n := len(items)
+		if n == 0 {
+			return nil
+		}
+		candidates := []*A.TypedArray[T, any]{A.New[T]()}
+		if n > 1 {
+			candidates = append(candidates,
+				A.NewFrom(append([]T(nil), items[:n/2]...)),
+				A.NewFrom(append([]T(nil), items[n/2:]...)),
+			)
+		}
+		for i := range items {
+			without := append(append([]T(nil), items[:i]...), items[i+1:]...)
+			candidates = append(candidates, A.NewFrom(without))
+		}
+		if elem != nil {
+			for i, v := range items {
+				for _, s := range elem(v) {
+					shrunk := append([]T(nil), items...)
+					shrunk[i] = s
+					candidates = append(candidates, A.NewFrom(shrunk))
+				}
+			}
+		}
+		return candidates
+	}
+}
+
+// ShrinkTypedMap shrinks a TypedMap by dropping its keys one by one.
+func ShrinkTypedMap[K comparable, V any](m *A.TypedMap[K, V]) []*A.TypedMap[K, V] {
+	if m.Len() == 0 {
+		return nil
+	}
+	candidates := []*A.TypedMap[K, V]{A.NewTypedMap[K, V]()}
+	m.ForEach(func(k K, _ V) {
+		without := m.Clone()
+		without.Delete(k)
+		candidates = append(candidates, without)
+	})
+	return candidates
+}
+
+// ShrinkSet shrinks a Set by dropping its elements one by one.
+func ShrinkSet[T comparable](s set.Set[T]) []set.Set[T] {
+	if s.Len() == 0 {
+		return nil
+	}
+	candidates := []set.Set[T]{set.New[T]()}
+	for v := range s {
+		without := s.Clone()
+		without.Delete(v)
+		candidates = append(candidates, without)
+	}
+	return candidates
+}
+
+// ShrinkOptional shrinks an Optional to nothing,
+// then shrinks its value with elem, which could be nil.
+func ShrinkOptional[T any](elem Shrinker[T]) Shrinker[*O.Optional[T]] {
+	return func(o *O.Optional[T]) []*O.Optional[T] {
+		if !o.IsSet() {
+			return nil
+		}
+		candidates := []*O.Optional[T]{O.Nothing[T]()}
+		if elem != nil {
+			for _, s := range elem(o.Value()) {
+				candidates = append(candidates, O.Just(s))
+			}
+		}
+		return candidates
+	}
+}
+
+// Options configures Check. Zero fields take their defaults.
+type Options struct {
+	// Runs is the number of values tried. It defaults to 100.
+	Runs int
+	// Size is the size of the last value, growing from 0 on the first run.
+	// It defaults to 50.
+	Size int
+	// Seed seeds the random values. It defaults to the current time,
+	// and is reported on failure to reproduce it.
+	Seed int64
+}
+
+// maxShrinks bounds the number of shrinking steps.
+const maxShrinks = 1000
+
+// Check tries prop with values generated by g, and fails the test
+// on the first value not satisfying it. The failing value is then
+// shrunk with shrink, which could be nil, to a minimal one to report.
+func Check[T any](t testing.TB, g Gen[T], shrink Shrinker[T], prop func(T) bool, opts Options) bool {
+	t.Helper()
+	if opts.Runs <= 0 {
+		opts.Runs = 100
+	}
+	if opts.Size <= 0 {
+		opts.Size = 50
+	}
+	if opts.Seed == 0 {
+		opts.Seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(opts.Seed))
+	for run := 0; run < opts.Runs; run++ {
+		v := g(r, run*opts.Size/max(opts.Runs-1, 1))
+		if prop(v) {
+			continue
+		}
+		minimal, steps := shrinkValue(v, shrink, prop)
+		t.Errorf("property failed (seed %d, run %d) with:\n%#v\nshrunk in %d steps from:\n%#v",
+			opts.Seed, run, minimal, steps, v)
+		return false
+	}
+	return true
+}
+
+// shrinkValue greedily takes the first failing candidate until none fails.
+func shrinkValue[T any](v T, shrink Shrinker[T], prop func(T) bool) (T, int) {
+	if shrink == nil {
+		return v, 0
+	}
+	steps := 0
+	for steps < maxShrinks {
+		shrunk := false
+		for _, c := range shrink(v) {
+			if !prop(c) {
+				v, shrunk = c, true
+				steps++
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return v, steps
+}
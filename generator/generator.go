@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"runtime"
+	"sync"
+
+	O "github.com/eicc27/Gophunc/optional"
+	S "github.com/eicc27/Gophunc/stream"
+)
+
+// Generator is a lazy iterator whose values are produced by imperative code.
+// The producer runs in its own goroutine, which starts on the first call of Next,
+// and is paused at yield until the next value is requested.
+//
+// Example:
+//
+//	g := generator.New(func(yield func(int)) {
+//		for i := 0; ; i++ {
+//			yield(i * i)
+//		}
+//	})
+//	defer g.Stop()
+//	g.Next() // Just(0)
+//	g.Next() // Just(1)
+type Generator[T any] struct {
+	produce  func(yield func(T))
+	values   chan T
+	stop     chan struct{}
+	done     chan struct{}
+	start    sync.Once
+	stopOnce sync.Once
+	panicked any
+}
+
+// New creates a Generator from a producer, which passes values to yield
+// one by one. The generator ends when the producer returns.
+func New[T any](produce func(yield func(T))) *Generator[T] {
+	return &Generator[T]{
+		produce: produce,
+		values:  make(chan T),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+func (g *Generator[T]) run() {
+	defer close(g.done)
+	defer close(g.values)
+	defer func() {
+		// a panic in the producer is raised again by Next
+		if v := recover(); v != nil {
+			g.panicked = v
+		}
+	}()
+	g.produce(func(v T) {
+		select {
+		case g.values <- v:
+		case <-g.stop:
+			// unwinds the producer, running its deferred calls
+			runtime.Goexit()
+		}
+	})
+}
+
+// Next resumes the producer and returns the value it yields.
+// It returns a nothing optional once the producer returns or the generator is stopped.
+// If the producer panics, Next panics with the same value.
+func (g *Generator[T]) Next() *O.Optional[T] {
+	g.start.Do(func() {
+		go g.run()
+	})
+	select {
+	case v, ok := <-g.values:
+		if ok {
+			return O.Just(v)
+		}
+	case <-g.stop:
+		return O.Nothing[T]()
+	}
+	<-g.done
+	if g.panicked != nil {
+		panic(g.panicked)
+	}
+	return O.Nothing[T]()
+}
+
+// Stop ends the generator early. A producer paused at yield is unwound,
+// so its deferred calls run before Stop returns.
+// It is safe to call Stop more than once, and it must be called
+// if the generator is not drained, or the producer goroutine leaks.
+func (g *Generator[T]) Stop() {
+	g.stopOnce.Do(func() {
+		close(g.stop)
+	})
+	started := true
+	g.start.Do(func() {
+		started = false
+	})
+	if started {
+		<-g.done
+	}
+}
+
+// ToStream returns a Stream pulling from the generator,
+// so it could be consumed with operations like Map and Filter.
+//
+// Example:
+//
+//	stream.Map(g.ToStream().Take(3), strconv.Itoa).ToArray()
+func (g *Generator[T]) ToStream() *S.Stream[T] {
+	return S.New(g.Next)
+}
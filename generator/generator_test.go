@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGenerator(t *testing.T) {
+	g := New(func(yield func(int)) {
+		for i := 0; i < 3; i++ {
+			yield(i)
+		}
+	})
+	got := make([]int, 0)
+	for v := g.Next(); v.IsSet(); v = g.Next() {
+		got = append(got, v.Value())
+	}
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Next gave %v", got)
+	}
+	if g.Next().IsSet() {
+		t.Error("Next after the producer returns should be nothing")
+	}
+}
+
+func TestGeneratorStop(t *testing.T) {
+	cleaned := false
+	g := New(func(yield func(int)) {
+		defer func() { cleaned = true }()
+		for i := 0; ; i++ {
+			yield(i)
+		}
+	})
+	g.Next()
+	g.Next()
+	g.Stop()
+	if !cleaned {
+		t.Error("Stop should run the deferred calls of the producer")
+	}
+	if g.Next().IsSet() {
+		t.Error("Next after Stop should be nothing")
+	}
+	g.Stop()
+}
+
+func TestGeneratorPanic(t *testing.T) {
+	g := New(func(yield func(int)) {
+		yield(1)
+		panic("boom")
+	})
+	g.Next()
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want the producer's panic", r)
+		}
+	}()
+	g.Next()
+}
+
+func TestGeneratorToStream(t *testing.T) {
+	g := New(func(yield func(int)) {
+		for i := 0; ; i++ {
+			yield(i)
+		}
+	})
+	defer g.Stop()
+	got := g.ToStream().Filter(func(i int) bool { return i%2 == 0 }).Take(3).ToArray().ToArray()
+	if !slices.Equal(got, []int{0, 2, 4}) {
+		t.Errorf("ToStream gave %v", got)
+	}
+}
@@ -0,0 +1,115 @@
+// Package gophunctest provides test assertions for Gophunc types.
+//
+// Every assertion marks itself as a test helper, reports a failure
+// with t.Errorf so the test goes on, and returns whether it passed.
+//
+// Example:
+//
+//	func TestUsers(t *testing.T) {
+//		users := array.New(alice, bob)
+//		gophunctest.AssertJust(t, users.SimpleFilter(isAdmin).AtSafe(0), alice)
+//		gophunctest.AssertErrIs(t, result.New(os.ReadFile("missing")), fs.ErrNotExist)
+//	}
+package gophunctest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// AssertJust asserts that o is set, and its value equals want.
+// Values are compared with reflect.DeepEqual.
+func AssertJust[T any](t testing.TB, o *O.Optional[T], want T) bool {
+	t.Helper()
+	if !o.IsSet() {
+		t.Errorf("expected Just(%#v), got Nothing", want)
+		return false
+	}
+	if !reflect.DeepEqual(o.Value(), want) {
+		t.Errorf("unexpected value of Just:\n%s", diff(want, o.Value()))
+		return false
+	}
+	return true
+}
+
+// AssertNothing asserts that o is not set.
+func AssertNothing[T any](t testing.TB, o *O.Optional[T]) bool {
+	t.Helper()
+	if o.IsSet() {
+		t.Errorf("expected Nothing, got Just(%#v)", o.Value())
+		return false
+	}
+	return true
+}
+
+// AssertOK asserts that r is successful, and its value equals want.
+// Values are compared with reflect.DeepEqual.
+func AssertOK[T any](t testing.TB, r *R.Result[T], want T) bool {
+	t.Helper()
+	if r.IsError() {
+		t.Errorf("expected OK(%#v), got error: %v", want, r.AsError())
+		return false
+	}
+	if !reflect.DeepEqual(r.AsOK(), want) {
+		t.Errorf("unexpected value of OK:\n%s", diff(want, r.AsOK()))
+		return false
+	}
+	return true
+}
+
+// AssertErrIs asserts that r fails with an error matching target,
+// as reported by errors.Is.
+func AssertErrIs[T any](t testing.TB, r *R.Result[T], target error) bool {
+	t.Helper()
+	if r.IsOK() {
+		t.Errorf("expected error %v, got OK(%#v)", target, r.AsOK())
+		return false
+	}
+	if !errors.Is(r.AsError(), target) {
+		t.Errorf("expected error %v, got: %v", target, r.AsError())
+		return false
+	}
+	return true
+}
+
+// AssertArrayEqual asserts that the elements of got equal want in order.
+// Elements are compared with reflect.DeepEqual, and a failure
+// lists every differing index.
+func AssertArrayEqual[T, U any](t testing.TB, got *A.TypedArray[T, U], want ...T) bool {
+	t.Helper()
+	actual := got.ToArray()
+	if len(actual) == len(want) && (len(want) == 0 || reflect.DeepEqual(actual, want)) {
+		return true
+	}
+	t.Errorf("arrays differ:\n%s", diffSlices(want, actual))
+	return false
+}
+
+func diff(want any, got any) string {
+	return fmt.Sprintf("- want: %#v\n+  got: %#v", want, got)
+}
+
+// diffSlices reports the differing indices of two slices,
+// marking missing and extra elements.
+func diffSlices[T any](want []T, got []T) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "length: want %d, got %d\n", len(want), len(got))
+	for i := 0; i < max(len(want), len(got)); i++ {
+		switch {
+		case i >= len(got):
+			fmt.Fprintf(&b, "[%d] - want: %#v\n", i, want[i])
+		case i >= len(want):
+			fmt.Fprintf(&b, "[%d] +  got: %#v\n", i, got[i])
+		case !reflect.DeepEqual(want[i], got[i]):
+			fmt.Fprintf(&b, "[%d] - want: %#v\n[%d] +  got: %#v\n", i, want[i], i, got[i])
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,106 @@
+package heap
+
+import (
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Heap is a binary heap ordered by less, i.e. a priority queue.
+// The item on the top is the one for which less holds against all others,
+// so less = a < b gives a min-heap.
+//
+// Example:
+//
+//	h := heap.New(func(a, b int) bool { return a < b })
+//	h.Push(3, 1, 2)
+//	fmt.Println(h.Pop().Value()) // 1
+type Heap[T any] struct {
+	items []T
+	less  func(T, T) bool
+}
+
+// New creates a new empty Heap ordered by less.
+func New[T any](less func(T, T) bool) *Heap[T] {
+	return &Heap[T]{
+		less: less,
+	}
+}
+
+// Push adds items to the Heap, each in O(log n).
+func (h *Heap[T]) Push(items ...T) *Heap[T] {
+	for _, v := range items {
+		h.items = append(h.items, v)
+		h.up(len(h.items) - 1)
+	}
+	return h
+}
+
+// Pop removes the item on the top of the Heap and returns it.
+// If the Heap is empty, it returns a nothing optional.
+func (h *Heap[T]) Pop() *O.Optional[T] {
+	if len(h.items) == 0 {
+		return O.Nothing[T]()
+	}
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.items[0] = h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	h.down(0)
+	return O.Just(top)
+}
+
+// Peek returns the item on the top of the Heap without removing it.
+// If the Heap is empty, it returns a nothing optional.
+func (h *Heap[T]) Peek() *O.Optional[T] {
+	if len(h.items) == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(h.items[0])
+}
+
+// Len returns the number of items in the Heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+func (h *Heap[T]) down(i int) {
+	for {
+		smallest := i
+		for _, c := range []int{2*i + 1, 2*i + 2} {
+			if c < len(h.items) && h.less(h.items[c], h.items[smallest]) {
+				smallest = c
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
+
+// TopN gets the n largest elements of an array according to less,
+// from the largest to the smallest.
+// It is the same as the TopN method of TypedArray.
+//
+// Example:
+//
+//	slowest := heap.TopN(requests, 10, func(a, b Request) bool {
+//		return a.Latency < b.Latency
+//	})
+func TopN[T, U any](a *A.TypedArray[T, U], n int, less func(T, T) bool) *A.TypedArray[T, any] {
+	return A.NewFrom(a.TopN(n, less).ToArray())
+}
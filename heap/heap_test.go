@@ -0,0 +1,37 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	A "github.com/eicc27/Gophunc/array"
+)
+
+func TestHeap(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	items := make([]int, 200)
+	for i := range items {
+		items[i] = rng.Intn(50)
+	}
+	h := New(func(a, b int) bool { return a < b }).Push(items...)
+	slices.Sort(items)
+	if got := h.Peek(); !got.IsSet() || got.Value() != items[0] {
+		t.Fatalf("Peek = %v, want %d", got, items[0])
+	}
+	for _, want := range items {
+		if got := h.Pop(); !got.IsSet() || got.Value() != want {
+			t.Fatalf("Pop = %v, want %d", got, want)
+		}
+	}
+	if h.Len() != 0 || h.Pop().IsSet() || h.Peek().IsSet() {
+		t.Error("a drained heap should be empty")
+	}
+}
+
+func TestTopN(t *testing.T) {
+	got := TopN(A.New(5, 1, 4, 2, 3), 3, func(a, b int) bool { return a < b }).ToArray()
+	if want := []int{5, 4, 3}; !slices.Equal(got, want) {
+		t.Errorf("TopN = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+// Package clone implements reflective deep copies shared by
+// the structs and array packages.
+package clone
+
+import "reflect"
+
+// Deep returns a deep copy of v.
+// Maps, slices, arrays, pointers, interfaces and exported struct fields
+// are copied recursively. Pointers shared inside v stay shared
+// in the copy, so cyclic structures are copied without looping forever.
+// Unexported struct fields, channels and functions are copied shallowly.
+func Deep[T any](v T) T {
+	src := reflect.ValueOf(&v).Elem()
+	dst := reflect.New(src.Type()).Elem()
+	copyValue(dst, src, make(map[uintptr]reflect.Value))
+	// a nil interface T asserts to nothing, so the zero T is returned
+	r, _ := dst.Interface().(T)
+	return r
+}
+
+func copyValue(dst reflect.Value, src reflect.Value, seen map[uintptr]reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		if p, ok := seen[src.Pointer()]; ok && p.Type() == src.Type() {
+			dst.Set(p)
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		seen[src.Pointer()] = p
+		copyValue(p.Elem(), src.Elem(), seen)
+		dst.Set(p)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		elem := reflect.New(src.Elem().Type()).Elem()
+		copyValue(elem, src.Elem(), seen)
+		dst.Set(elem)
+	case reflect.Struct:
+		// copies all fields shallowly first, as unexported ones could not be set
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				copyValue(dst.Field(i), src.Field(i), seen)
+			}
+		}
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			copyValue(s.Index(i), src.Index(i), seen)
+		}
+		dst.Set(s)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			copyValue(dst.Index(i), src.Index(i), seen)
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			k := reflect.New(src.Type().Key()).Elem()
+			copyValue(k, iter.Key(), seen)
+			v := reflect.New(src.Type().Elem()).Elem()
+			copyValue(v, iter.Value(), seen)
+			m.SetMapIndex(k, v)
+		}
+		dst.Set(m)
+	default:
+		dst.Set(src)
+	}
+}
@@ -0,0 +1,93 @@
+// Package fields implements the struct field reflection shared by
+// the structs and array packages.
+package fields
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TagName gets the name of a field in the given struct tag.
+// It falls back to the field name if the tag is missing or has no name,
+// and reports false if the field is skipped with "-".
+func TagName(field reflect.StructField, tag string) (string, bool) {
+	value, ok := field.Tag.Lookup(tag)
+	if value == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(value, ",")
+	if !ok || name == "" {
+		return field.Name, true
+	}
+	return name, true
+}
+
+// ByTag maps the names of the exported fields of a struct type,
+// as given by TagName, to their indices.
+func ByTag(t reflect.Type, tag string) map[string]int {
+	names := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		if name, ok := TagName(t.Field(i), tag); ok {
+			names[name] = i
+		}
+	}
+	return names
+}
+
+// Parse parses s into v according to its type.
+// It supports strings, booleans, numbers, pointers to them,
+// and types implementing encoding.TextUnmarshaler.
+// An empty s leaves v unchanged.
+func Parse(v reflect.Value, s string) error {
+	if s == "" {
+		return nil
+	}
+	if v.CanAddr() {
+		if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(s))
+		}
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Pointer:
+		p := reflect.New(v.Type().Elem())
+		if err := Parse(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
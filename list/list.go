@@ -0,0 +1,152 @@
+package list
+
+import (
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// List is a persistent singly-linked list.
+// A List is never modified: Cons returns a new list sharing
+// the original one as its tail, so prepending takes O(1)
+// and older versions stay valid.
+//
+// The empty list is a nil *List[T], on which all methods work.
+//
+// Example:
+//
+//	l1 := list.New(2, 3)
+//	l2 := l1.Cons(1) // 1, 2, 3, sharing l1
+type List[T any] struct {
+	head T
+	tail *List[T]
+	size int
+}
+
+// Empty returns the empty List.
+func Empty[T any]() *List[T] {
+	return nil
+}
+
+// New creates a new List with some items in order.
+func New[T any](items ...T) *List[T] {
+	var l *List[T]
+	for i := len(items) - 1; i >= 0; i-- {
+		l = l.Cons(items[i])
+	}
+	return l
+}
+
+// FromArray creates a new List from the elements of a TypedArray.
+func FromArray[T, U any](a *A.TypedArray[T, U]) *List[T] {
+	return New(a.ToArray()...)
+}
+
+// Cons returns a new List with v prepended to l.
+func (l *List[T]) Cons(v T) *List[T] {
+	return &List[T]{
+		head: v,
+		tail: l,
+		size: l.Len() + 1,
+	}
+}
+
+// Head returns the first item of the List,
+// or a nothing optional if the List is empty.
+func (l *List[T]) Head() *O.Optional[T] {
+	if l == nil {
+		return O.Nothing[T]()
+	}
+	return O.Just(l.head)
+}
+
+// Tail returns the List without its first item,
+// or a nothing optional if the List is empty.
+func (l *List[T]) Tail() *O.Optional[*List[T]] {
+	if l == nil {
+		return O.Nothing[*List[T]]()
+	}
+	return O.Just(l.tail)
+}
+
+// Len returns the number of items in the List in O(1).
+func (l *List[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.size
+}
+
+// ForEach applies f to each item in order.
+func (l *List[T]) ForEach(f func(T)) *List[T] {
+	for n := l; n != nil; n = n.tail {
+		f(n.head)
+	}
+	return l
+}
+
+// Reverse returns a new List with the items in reverse order.
+func (l *List[T]) Reverse() *List[T] {
+	return Fold(l, Empty[T](), func(acc *List[T], v T) *List[T] {
+		return acc.Cons(v)
+	})
+}
+
+// Filter returns a new List with the items satisfying f,
+// which is applied from head to tail.
+// The longest suffix in which every item satisfies f is shared.
+func (l *List[T]) Filter(f func(T) bool) *List[T] {
+	nodes := make([]*List[T], 0, l.Len())
+	keep := make([]bool, 0, l.Len())
+	for n := l; n != nil; n = n.tail {
+		nodes = append(nodes, n)
+		keep = append(keep, f(n.head))
+	}
+	// finds the longest suffix to share
+	shared := len(nodes)
+	for shared > 0 && keep[shared-1] {
+		shared--
+	}
+	var result *List[T]
+	if shared < len(nodes) {
+		result = nodes[shared]
+	}
+	for i := shared - 1; i >= 0; i-- {
+		if keep[i] {
+			result = result.Cons(nodes[i].head)
+		}
+	}
+	return result
+}
+
+// ToTypedArray copies the items into a TypedArray in order.
+func (l *List[T]) ToTypedArray() *A.TypedArray[T, any] {
+	items := make([]T, 0, l.Len())
+	l.ForEach(func(v T) {
+		items = append(items, v)
+	})
+	return A.New(items...)
+}
+
+// Map applies f to each item of a List and returns a new List.
+// It is a top-level function since the type of items could change.
+func Map[T, U any](l *List[T], f func(T) U) *List[U] {
+	// f is applied from head to tail, before the List is built from its tail
+	items := make([]U, 0, l.Len())
+	l.ForEach(func(v T) {
+		items = append(items, f(v))
+	})
+	return New(items...)
+}
+
+// Fold reduces a List from left to right, starting from init.
+//
+// Example:
+//
+//	sum := list.Fold(list.New(1, 2, 3), 0, func(acc, v int) int { return acc + v }) // 6
+func Fold[T, R any](l *List[T], init R, f func(R, T) R) R {
+	acc := init
+	l.ForEach(func(v T) {
+		acc = f(acc, v)
+	})
+	return acc
+}
@@ -0,0 +1,73 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	l := New(1, 2, 3)
+	l2 := l.Cons(0)
+	if got := l2.ToTypedArray().ToArray(); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("Cons = %v", got)
+	}
+	if l.Len() != 3 || l2.Len() != 4 {
+		t.Error("Cons should leave the original list unchanged")
+	}
+	if h := l.Head(); !h.IsSet() || h.Value() != 1 {
+		t.Errorf("Head = %v, want 1", h)
+	}
+	if tail := l.Tail(); !tail.IsSet() || tail.Value().Len() != 2 {
+		t.Errorf("Tail = %v, want a list of 2", tail)
+	}
+	if Empty[int]().Head().IsSet() || Empty[int]().Tail().IsSet() {
+		t.Error("an empty list has no head and no tail")
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	if got := l.Reverse().ToTypedArray().ToArray(); !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("Reverse = %v", got)
+	}
+	if got := l.Filter(func(i int) bool { return i%2 == 0 }).ToTypedArray().ToArray(); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("Filter = %v", got)
+	}
+	if got := Map(l, func(i int) int { return i * 10 }).ToTypedArray().ToArray(); !slices.Equal(got, []int{10, 20, 30, 40}) {
+		t.Errorf("Map = %v", got)
+	}
+	if got := Fold(l, 0, func(acc int, i int) int { return acc + i }); got != 10 {
+		t.Errorf("Fold = %d, want 10", got)
+	}
+}
+
+func TestCallbackOrder(t *testing.T) {
+	l := New(1, 2, 3)
+	visited := make([]int, 0)
+	Map(l, func(i int) int {
+		visited = append(visited, i)
+		return i
+	})
+	if !slices.Equal(visited, []int{1, 2, 3}) {
+		t.Errorf("Map called f on %v, want head to tail", visited)
+	}
+	visited = visited[:0]
+	l.Filter(func(i int) bool {
+		visited = append(visited, i)
+		return i != 2
+	})
+	if !slices.Equal(visited, []int{1, 2, 3}) {
+		t.Errorf("Filter called f on %v, want head to tail", visited)
+	}
+}
+
+func TestFilterShares(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	filtered := l.Filter(func(i int) bool { return i != 2 })
+	if got := filtered.ToTypedArray().ToArray(); !slices.Equal(got, []int{1, 3, 4}) {
+		t.Errorf("Filter = %v", got)
+	}
+	if filtered.tail != l.tail.tail {
+		t.Error("Filter should share the suffix satisfying f")
+	}
+}
@@ -0,0 +1,67 @@
+package match
+
+import (
+	"reflect"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Matcher branches over a value declaratively.
+// Branches are tried in order, and only the handler of the first
+// matching branch is called.
+//
+// Example:
+//
+//	size := match.Match[int, string](n).
+//		WhenValue(0, func(int) string { return "none" }).
+//		When(func(n int) bool { return n < 10 }, func(int) string { return "few" }).
+//		Default(func(int) string { return "many" }).
+//		Result().Value()
+//
+// Methods of the matched type work as predicates as well:
+//
+//	match.Match[*optional.Optional[int], int](o).
+//		When((*optional.Optional[int]).IsSet, (*optional.Optional[int]).Value).
+//		Default(func(*optional.Optional[int]) int { return -1 })
+type Matcher[T, R any] struct {
+	value  T
+	result *O.Optional[R]
+}
+
+// Match starts matching a value.
+// R is the type of the result of the handlers.
+func Match[T, R any](value T) *Matcher[T, R] {
+	return &Matcher[T, R]{
+		value:  value,
+		result: O.Nothing[R](),
+	}
+}
+
+// When adds a branch matching when pred returns true for the value.
+func (m *Matcher[T, R]) When(pred func(T) bool, handler func(T) R) *Matcher[T, R] {
+	if !m.result.IsSet() && pred(m.value) {
+		m.result = O.Just(handler(m.value))
+	}
+	return m
+}
+
+// WhenValue adds a branch matching when the value deeply equals v.
+func (m *Matcher[T, R]) WhenValue(v T, handler func(T) R) *Matcher[T, R] {
+	return m.When(func(t T) bool {
+		return reflect.DeepEqual(t, v)
+	}, handler)
+}
+
+// Default adds a branch that always matches.
+// It should be the last branch.
+func (m *Matcher[T, R]) Default(handler func(T) R) *Matcher[T, R] {
+	return m.When(func(T) bool {
+		return true
+	}, handler)
+}
+
+// Result returns the result of the matched handler,
+// or a nothing optional if no branch matches.
+func (m *Matcher[T, R]) Result() *O.Optional[R] {
+	return m.result
+}
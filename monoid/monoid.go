@@ -0,0 +1,113 @@
+package monoid
+
+import (
+	A "github.com/eicc27/Gophunc/array"
+	"github.com/eicc27/Gophunc/numeric"
+	"github.com/eicc27/Gophunc/set"
+)
+
+// Semigroup combines two values of the same type associatively:
+// Combine(Combine(a, b), c) == Combine(a, Combine(b, c)).
+type Semigroup[T any] interface {
+	Combine(a, b T) T
+}
+
+// Monoid is a Semigroup with an identity value Empty:
+// Combine(Empty(), a) == Combine(a, Empty()) == a.
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+type monoid[T any] struct {
+	empty   func() T
+	combine func(a, b T) T
+}
+
+func (m monoid[T]) Combine(a, b T) T {
+	return m.combine(a, b)
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty()
+}
+
+// New creates a Monoid from an identity value constructor and a combine function.
+// empty is called every time an identity value is needed,
+// so it could return fresh mutable values like maps.
+func New[T any](empty func() T, combine func(a, b T) T) Monoid[T] {
+	return monoid[T]{empty: empty, combine: combine}
+}
+
+// Sum combines numbers by addition, with 0 as the identity.
+func Sum[T numeric.Number]() Monoid[T] {
+	return New(func() T { return 0 }, func(a, b T) T { return a + b })
+}
+
+// Product combines numbers by multiplication, with 1 as the identity.
+func Product[T numeric.Number]() Monoid[T] {
+	return New(func() T { return 1 }, func(a, b T) T { return a * b })
+}
+
+// String combines strings by concatenation, with "" as the identity.
+func String() Monoid[string] {
+	return New(func() string { return "" }, func(a, b string) string { return a + b })
+}
+
+// Slice combines slices by concatenation, with an empty slice as the identity.
+// The combined slice is always a new one.
+func Slice[T any]() Monoid[[]T] {
+	return New(func() []T { return make([]T, 0) }, func(a, b []T) []T {
+		result := make([]T, 0, len(a)+len(b))
+		return append(append(result, a...), b...)
+	})
+}
+
+// Set combines sets by union, with an empty set as the identity.
+// The combined set is always a new one.
+func Set[T comparable]() Monoid[set.Set[T]] {
+	return New(func() set.Set[T] { return set.New[T]() }, func(a, b set.Set[T]) set.Set[T] {
+		result := a.Clone()
+		for k := range b {
+			result.Add(k)
+		}
+		return result
+	})
+}
+
+// All combines booleans by logical and, with true as the identity.
+func All() Monoid[bool] {
+	return New(func() bool { return true }, func(a, b bool) bool { return a && b })
+}
+
+// Any combines booleans by logical or, with false as the identity.
+func Any() Monoid[bool] {
+	return New(func() bool { return false }, func(a, b bool) bool { return a || b })
+}
+
+// Concat combines values from left to right with a Monoid.
+// For no value at all, it returns the identity.
+func Concat[T any](m Monoid[T], values ...T) T {
+	result := m.Empty()
+	for _, v := range values {
+		result = m.Combine(result, v)
+	}
+	return result
+}
+
+// FoldMap maps each element of an array with f,
+// and combines the results with a Monoid.
+// Different from Reduce, it works on empty arrays as well.
+//
+// Example:
+//
+//	total := monoid.FoldMap(orders, monoid.Sum[float64](), func(o Order) float64 {
+//		return o.Price
+//	})
+func FoldMap[T, U, M any](a *A.TypedArray[T, U], m Monoid[M], f func(T) M) M {
+	result := m.Empty()
+	for _, v := range a.ToArray() {
+		result = m.Combine(result, f(v))
+	}
+	return result
+}
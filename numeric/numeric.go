@@ -0,0 +1,141 @@
+package numeric
+
+import (
+	"math"
+	"slices"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Number is the set of built-in integer and floating point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum adds up the elements of an array. The sum of an empty array is 0.
+func Sum[T Number, U any](a *A.TypedArray[T, U]) T {
+	var sum T
+	for _, v := range a.ToArray() {
+		sum += v
+	}
+	return sum
+}
+
+// Mean gets the arithmetic mean of the elements of an array.
+// If the array is empty, it returns a nothing optional.
+func Mean[T Number, U any](a *A.TypedArray[T, U]) *O.Optional[float64] {
+	if a.Length() == 0 {
+		return O.Nothing[float64]()
+	}
+	sum := 0.0
+	for _, v := range a.ToArray() {
+		sum += float64(v)
+	}
+	return O.Just(sum / float64(a.Length()))
+}
+
+// Median gets the middle value of the sorted elements of an array.
+// For an even number of elements, it is the mean of the two middle values.
+// If the array is empty, it returns a nothing optional.
+func Median[T Number, U any](a *A.TypedArray[T, U]) *O.Optional[float64] {
+	return Percentile(a, 50)
+}
+
+// Percentile gets the p-th percentile of the elements of an array,
+// with p in [0, 100], interpolating linearly between the closest ranks.
+// If the array is empty or p is out of range, it returns a nothing optional.
+//
+// Example:
+//
+//	p99 := numeric.Percentile(latencies, 99)
+func Percentile[T Number, U any](a *A.TypedArray[T, U], p float64) *O.Optional[float64] {
+	if a.Length() == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return O.Nothing[float64]()
+	}
+	sorted := slices.Clone(a.ToArray())
+	slices.Sort(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return O.Just(float64(sorted[lower])*(1-weight) + float64(sorted[upper])*weight)
+}
+
+// Variance gets the population variance of the elements of an array.
+// If the array is empty, it returns a nothing optional.
+func Variance[T Number, U any](a *A.TypedArray[T, U]) *O.Optional[float64] {
+	mean := Mean(a)
+	if !mean.IsSet() {
+		return O.Nothing[float64]()
+	}
+	sum := 0.0
+	for _, v := range a.ToArray() {
+		d := float64(v) - mean.Value()
+		sum += d * d
+	}
+	return O.Just(sum / float64(a.Length()))
+}
+
+// StdDev gets the population standard deviation of the elements of an array.
+// If the array is empty, it returns a nothing optional.
+func StdDev[T Number, U any](a *A.TypedArray[T, U]) *O.Optional[float64] {
+	return Variance(a).Then(math.Sqrt)
+}
+
+// Mode gets the most frequent element of an array.
+// If several elements are the most frequent, the one appearing first wins.
+// If the array is empty, it returns a nothing optional.
+func Mode[T Number, U any](a *A.TypedArray[T, U]) *O.Optional[T] {
+	if a.Length() == 0 {
+		return O.Nothing[T]()
+	}
+	counts := make(map[T]int)
+	for _, v := range a.ToArray() {
+		counts[v]++
+	}
+	mode := a.ToArray()[0]
+	for _, v := range a.ToArray() {
+		if counts[v] > counts[mode] {
+			mode = v
+		}
+	}
+	return O.Just(mode)
+}
+
+// Bucketize counts the elements of an array falling into the buckets
+// delimited by boundaries, which are sorted first.
+// Each bucket is keyed by its inclusive lower bound: boundaries b1 < b2
+// give the buckets [-Inf, b1), [b1, b2) and [b2, +Inf).
+// Every bucket is in the map, including the empty ones.
+//
+// Example:
+//
+//	latencies := array.New(3, 12, 45, 230, 8)
+//	counts := numeric.Bucketize(latencies, []float64{10, 100})
+//	// -Inf: 2, 10: 2, 100: 1
+func Bucketize[T Number, U any](a *A.TypedArray[T, U], boundaries []float64) *A.TypedMap[float64, int] {
+	bounds := slices.Clone(boundaries)
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+	counts := A.NewTypedMap[float64, int]()
+	counts.Set(math.Inf(-1), 0)
+	for _, b := range bounds {
+		counts.Set(b, 0)
+	}
+	for _, v := range a.ToArray() {
+		// the number of boundaries not greater than v
+		i, found := slices.BinarySearch(bounds, float64(v))
+		if found {
+			i++
+		}
+		lower := math.Inf(-1)
+		if i > 0 {
+			lower = bounds[i-1]
+		}
+		counts.Set(lower, counts.GetOrDefault(lower, 0)+1)
+	}
+	return counts
+}
@@ -0,0 +1,261 @@
+package observable
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Observable is a push-based stream of values, which ends either
+// by completing or by failing with an error.
+//
+// It is cold: nothing is produced until it is subscribed,
+// and every subscription runs the producer anew.
+// Different from a Promise, it delivers any number of values over time.
+//
+// Example:
+//
+//	clicks := observable.FromChannel(events)
+//	observable.Map(clicks.Filter(isDouble), toCommand).
+//		Subscribe(ctx, run, func(err error) { log.Println("done", err) })
+type Observable[T any] struct {
+	produce func(ctx context.Context, emit func(T) bool) error
+}
+
+// Create creates an Observable from a producer.
+// The producer passes values to emit, and should stop as soon as
+// emit returns false or ctx is done. It returns nil to complete,
+// or an error to fail the Observable.
+func Create[T any](produce func(ctx context.Context, emit func(T) bool) error) *Observable[T] {
+	return &Observable[T]{
+		produce: produce,
+	}
+}
+
+// Of creates an Observable emitting some items and then completing.
+func Of[T any](items ...T) *Observable[T] {
+	return Create(func(_ context.Context, emit func(T) bool) error {
+		for _, v := range items {
+			if !emit(v) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// FromChannel creates an Observable emitting the values received from ch,
+// which completes when ch is closed.
+// As a channel could be drained only once, so could the Observable.
+func FromChannel[T any](ch <-chan T) *Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok || !emit(v) {
+					return nil
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+}
+
+// Subscribe starts the Observable in a new goroutine.
+// onNext is called with every value, one at a time.
+// onDone is called exactly once at the end, with nil on completion,
+// with the error on failure, or with the context error on cancellation.
+// Both callbacks could be nil.
+//
+// It returns a function that cancels the subscription.
+func (o *Observable[T]) Subscribe(ctx context.Context, onNext func(T), onDone func(error)) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		err := o.produce(ctx, func(v T) bool {
+			if ctx.Err() != nil {
+				return false
+			}
+			if onNext != nil {
+				onNext(v)
+			}
+			return true
+		})
+		if err == nil {
+			err = ctx.Err()
+		}
+		if onDone != nil {
+			onDone(err)
+		}
+	}()
+	return cancel
+}
+
+// ToChannel subscribes to the Observable and delivers its values
+// to a channel as OK results. A failure is delivered as a final error result.
+// The channel is closed at the end, or when ctx is done.
+func (o *Observable[T]) ToChannel(ctx context.Context) <-chan R.Result[T] {
+	out := make(chan R.Result[T])
+	go func() {
+		defer close(out)
+		err := o.produce(ctx, func(v T) bool {
+			select {
+			case out <- *R.OK(v):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			select {
+			case out <- *R.Error[T](err):
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return out
+}
+
+// Filter keeps the values satisfying f.
+func (o *Observable[T]) Filter(f func(T) bool) *Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		return o.produce(ctx, func(v T) bool {
+			return !f(v) || emit(v)
+		})
+	})
+}
+
+// Debounce emits a value only after d has passed without a newer value,
+// dropping the values superseded within d.
+// The last pending value is emitted on completion.
+func (o *Observable[T]) Debounce(d time.Duration) *Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		values := make(chan T)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- o.produce(ctx, func(v T) bool {
+				select {
+				case values <- v:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+		}()
+		var pending T
+		hasPending := false
+		// a single timer is reset for every value; with the timer semantics
+		// before Go 1.23, a fire not received yet must be drained on Stop,
+		// or it would be taken for the next value
+		timer := time.NewTimer(d)
+		stopTimer := func() {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		}
+		stopTimer()
+		defer timer.Stop()
+		for {
+			select {
+			case v := <-values:
+				pending, hasPending = v, true
+				stopTimer()
+				timer.Reset(d)
+			case <-timer.C:
+				if !hasPending {
+					continue
+				}
+				hasPending = false
+				if !emit(pending) {
+					return nil
+				}
+			case err := <-errc:
+				if err == nil && hasPending {
+					emit(pending)
+				}
+				return err
+			}
+		}
+	})
+}
+
+// Map applies f to each value of an Observable.
+// It is a top-level function since the type of values could change.
+func Map[T, U any](o *Observable[T], f func(T) U) *Observable[U] {
+	return Create(func(ctx context.Context, emit func(U) bool) error {
+		return o.produce(ctx, func(v T) bool {
+			return emit(f(v))
+		})
+	})
+}
+
+// Buffer groups the values of an Observable into slices of n values.
+// The last, possibly shorter, slice is emitted on completion.
+func Buffer[T any](o *Observable[T], n int) *Observable[[]T] {
+	n = max(n, 1)
+	return Create(func(ctx context.Context, emit func([]T) bool) error {
+		batch := make([]T, 0, n)
+		err := o.produce(ctx, func(v T) bool {
+			batch = append(batch, v)
+			if len(batch) < n {
+				return true
+			}
+			full := batch
+			batch = make([]T, 0, n)
+			return emit(full)
+		})
+		if err == nil && len(batch) > 0 {
+			emit(batch)
+		}
+		return err
+	})
+}
+
+// Merge emits the values of several Observables as they come.
+// It completes after all of them complete,
+// and fails as soon as any of them fails, cancelling the others.
+func Merge[T any](observables ...*Observable[T]) *Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var mu sync.Mutex
+		var once sync.Once
+		var firstErr error
+		var wg sync.WaitGroup
+		for _, o := range observables {
+			wg.Add(1)
+			go func(o *Observable[T]) {
+				defer wg.Done()
+				err := o.produce(ctx, func(v T) bool {
+					// values are emitted one at a time
+					mu.Lock()
+					defer mu.Unlock()
+					if ctx.Err() != nil {
+						return false
+					}
+					if !emit(v) {
+						cancel()
+						return false
+					}
+					return true
+				})
+				if err != nil && ctx.Err() == nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}(o)
+		}
+		wg.Wait()
+		return firstErr
+	})
+}
@@ -0,0 +1,55 @@
+package observable
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDebounce(t *testing.T) {
+	source := Create(func(ctx context.Context, emit func(int) bool) error {
+		for _, burst := range [][]int{{1, 2, 3}, {4, 5}, {6}} {
+			for _, v := range burst {
+				if !emit(v) {
+					return nil
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		// the last value is emitted on completion, not by the timer
+		emit(7)
+		return nil
+	})
+	got := make([]int, 0)
+	for r := range source.Debounce(20 * time.Millisecond).ToChannel(context.Background()) {
+		got = append(got, r.AsOK())
+	}
+	if want := []int{3, 5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("Debounce = %v, want %v", got, want)
+	}
+}
+
+func TestDebounceNoDuplicates(t *testing.T) {
+	// values arrive around the time the timer fires,
+	// which must never emit a value twice
+	source := Create(func(ctx context.Context, emit func(int) bool) error {
+		for i := 0; i < 50; i++ {
+			if !emit(i) {
+				return nil
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return nil
+	})
+	seen := make(map[int]bool)
+	for r := range source.Debounce(time.Millisecond).ToChannel(context.Background()) {
+		if seen[r.AsOK()] {
+			t.Fatalf("%d emitted twice", r.AsOK())
+		}
+		seen[r.AsOK()] = true
+	}
+	if !seen[49] {
+		t.Error("the last value was not emitted")
+	}
+}
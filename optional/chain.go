@@ -0,0 +1,50 @@
+package optional
+
+// FromPtr creates an Optional[T] from a pointer,
+// which is nothing if the pointer is nil.
+func FromPtr[T any](p *T) *Optional[T] {
+	if p == nil {
+		return Nothing[T]()
+	}
+	return Just(*p)
+}
+
+// FlatMap applies f to the value of an Optional[A] if it is set,
+// where f could fail by returning a nothing optional.
+// It is a top-level function since the type of the value could change.
+func FlatMap[A, B any](o *Optional[A], f func(A) *Optional[B]) *Optional[B] {
+	if !o.isSet {
+		return Nothing[B]()
+	}
+	return f(o.value)
+}
+
+// Chain2 applies two accessors in turn, each of which could fail,
+// and stops at the first one returning a nothing optional.
+// It replaces nested IsSet checks when digging through nested structures.
+//
+// Example:
+//
+//	city := optional.Chain3(optional.FromPtr(order),
+//		func(o Order) *optional.Optional[Customer] { return optional.FromPtr(o.Customer) },
+//		func(c Customer) *optional.Optional[Address] { return optional.FromPtr(c.Address) },
+//		func(a Address) *optional.Optional[string] { return optional.Just(a.City) },
+//	)
+func Chain2[A, B, C any](o *Optional[A], f func(A) *Optional[B], g func(B) *Optional[C]) *Optional[C] {
+	return FlatMap(FlatMap(o, f), g)
+}
+
+// Chain3 applies three accessors in turn, like Chain2.
+func Chain3[A, B, C, D any](o *Optional[A], f func(A) *Optional[B], g func(B) *Optional[C], h func(C) *Optional[D]) *Optional[D] {
+	return FlatMap(Chain2(o, f, g), h)
+}
+
+// Chain4 applies four accessors in turn, like Chain2.
+func Chain4[A, B, C, D, E any](o *Optional[A], f func(A) *Optional[B], g func(B) *Optional[C], h func(C) *Optional[D], i func(D) *Optional[E]) *Optional[E] {
+	return FlatMap(Chain3(o, f, g, h), i)
+}
+
+// Chain5 applies five accessors in turn, like Chain2.
+func Chain5[A, B, C, D, E, F any](o *Optional[A], f func(A) *Optional[B], g func(B) *Optional[C], h func(C) *Optional[D], i func(D) *Optional[E], j func(E) *Optional[F]) *Optional[F] {
+	return FlatMap(Chain4(o, f, g, h, i), j)
+}
@@ -0,0 +1,79 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+)
+
+// GobEncode implements gob.GobEncoder, so an Optional[T]
+// could be persisted or sent over RPC with encoding/gob.
+// Like any value sent with gob, an interface T must have
+// its concrete types registered with gob.Register.
+//
+// Encoding methods have value receivers, so Optional fields of
+// a struct value are encoded as well, while decoding needs a pointer.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(o.isSet); err != nil {
+		return nil, err
+	}
+	if !o.isSet {
+		return buf.Bytes(), nil
+	}
+	// gob could not encode a nil pointer, so it is flagged instead
+	isNil := isNilValue(o.value)
+	if err := enc.Encode(isNil); err != nil {
+		return nil, err
+	}
+	if !isNil {
+		if err := enc.Encode(&o.value); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var isSet bool
+	if err := dec.Decode(&isSet); err != nil {
+		return err
+	}
+	var value T
+	if isSet {
+		var isNil bool
+		if err := dec.Decode(&isNil); err != nil {
+			return err
+		}
+		if !isNil {
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+		}
+	}
+	o.value, o.isSet = value, isSet
+	return nil
+}
+
+// isNilValue checks if v is a nil pointer, interface, map, slice, channel or function.
+func isNilValue[T any](v T) bool {
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the gob encoding.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	return o.GobEncode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler with the gob encoding.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	return o.GobDecode(data)
+}
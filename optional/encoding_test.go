@@ -0,0 +1,45 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func roundTrip[T any](t *testing.T, o Optional[T]) Optional[T] {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(o); err != nil {
+		t.Fatalf("encoding %v: %v", o, err)
+	}
+	var decoded Optional[T]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("decoding %v: %v", o, err)
+	}
+	return decoded
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	if got := roundTrip(t, *Just(42)); !got.IsSet() || got.Value() != 42 {
+		t.Errorf("Just(42) decoded as %v", got)
+	}
+	if got := roundTrip(t, Optional[string]{}); got.IsSet() {
+		t.Errorf("the zero Optional decoded as %v", got)
+	}
+	if got := roundTrip(t, *Just(0)); !got.IsSet() || got.Value() != 0 {
+		t.Errorf("Just(0) decoded as %v", got)
+	}
+}
+
+func TestGobRoundTripNil(t *testing.T) {
+	if got := roundTrip(t, *Just[*int](nil)); !got.IsSet() || got.Value() != nil {
+		t.Errorf("Just(nil) decoded as %v", got)
+	}
+	if got := roundTrip(t, *Just[[]int](nil)); !got.IsSet() || got.Value() != nil {
+		t.Errorf("Just of a nil slice decoded as %v", got)
+	}
+	n := 3
+	if got := roundTrip(t, *Just(&n)); !got.IsSet() || *got.Value() != 3 {
+		t.Errorf("Just(&3) decoded as %v", got)
+	}
+}
@@ -22,6 +22,23 @@ func Nothing[T any]() *Optional[T] {
 	}
 }
 
+// Some creates an Optional[T] with a value.
+// Different from Just, it returns the Optional[T] by value,
+// which stays on the stack instead of being allocated on the heap.
+// It suits hot loops, like a filter-map over a large array.
+func Some[T any](t T) Optional[T] {
+	return Optional[T]{
+		value: t,
+		isSet: true,
+	}
+}
+
+// None creates an Optional[T] without a value.
+// Like Some, it returns the Optional[T] by value.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
 // Whether the optional value is set.
 func (o *Optional[T]) IsSet() bool {
 	return o.isSet
@@ -0,0 +1,66 @@
+package pipe
+
+import "github.com/eicc27/Gophunc/fn"
+
+// Pipeline carries a value through a chain of transformations.
+// As methods could not introduce new type parameters in Go,
+// Then only keeps the type, while the top-level Map changes it.
+//
+// Example:
+//
+//	n := pipe.Map(pipe.Of("  42 ").Then(strings.TrimSpace), utf8.RuneCountInString).Value() // 2
+type Pipeline[T any] struct {
+	value T
+}
+
+// Of starts a new Pipeline with a value.
+func Of[T any](value T) *Pipeline[T] {
+	return &Pipeline[T]{
+		value: value,
+	}
+}
+
+// Then applies f to the value of the Pipeline. Chainable.
+func (p *Pipeline[T]) Then(f func(T) T) *Pipeline[T] {
+	return Of(f(p.value))
+}
+
+// Value returns the value at the end of the Pipeline.
+func (p *Pipeline[T]) Value() T {
+	return p.value
+}
+
+// Map applies f to the value of a Pipeline, which could change its type.
+func Map[T, U any](p *Pipeline[T], f func(T) U) *Pipeline[U] {
+	return Of(f(p.value))
+}
+
+// P2 passes a value through two functions from top to bottom.
+// Together with P3, P4 and P5, it makes type-changing chains
+// read in the order they run.
+//
+// Example:
+//
+//	s := pipe.P3(" 42 ",
+//		strings.TrimSpace,
+//		func(s string) int { n, _ := strconv.Atoi(s); return n },
+//		func(n int) string { return strconv.Itoa(n * 2) },
+//	)
+func P2[A, B, C any](a A, f func(A) B, g func(B) C) C {
+	return fn.Pipe2(f, g)(a)
+}
+
+// P3 passes a value through three functions from top to bottom.
+func P3[A, B, C, D any](a A, f func(A) B, g func(B) C, h func(C) D) D {
+	return fn.Pipe3(f, g, h)(a)
+}
+
+// P4 passes a value through four functions from top to bottom.
+func P4[A, B, C, D, E any](a A, f func(A) B, g func(B) C, h func(C) D, i func(D) E) E {
+	return fn.Pipe4(f, g, h, i)(a)
+}
+
+// P5 passes a value through five functions from top to bottom.
+func P5[A, B, C, D, E, F any](a A, f func(A) B, g func(B) C, h func(C) D, i func(D) E, j func(E) F) F {
+	return fn.Pipe5(f, g, h, i, j)(a)
+}
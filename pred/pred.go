@@ -0,0 +1,61 @@
+package pred
+
+// And combines two predicates, holding when both of them hold.
+// g is not called if f does not hold.
+//
+// Example:
+//
+//	isAdult := func(u User) bool { return u.Age >= 18 }
+//	isActive := func(u User) bool { return u.Active }
+//	users.SimpleFilter(pred.And(isAdult, pred.Not(isActive)))
+func And[T any](f func(T) bool, g func(T) bool) func(T) bool {
+	return func(t T) bool {
+		return f(t) && g(t)
+	}
+}
+
+// Or combines two predicates, holding when either of them holds.
+// g is not called if f holds.
+func Or[T any](f func(T) bool, g func(T) bool) func(T) bool {
+	return func(t T) bool {
+		return f(t) || g(t)
+	}
+}
+
+// Not negates a predicate.
+func Not[T any](f func(T) bool) func(T) bool {
+	return func(t T) bool {
+		return !f(t)
+	}
+}
+
+// AllOf combines predicates, holding when all of them hold.
+// It holds for no predicate at all.
+func AllOf[T any](fs ...func(T) bool) func(T) bool {
+	return func(t T) bool {
+		for _, f := range fs {
+			if !f(t) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf combines predicates, holding when any of them holds.
+// It does not hold for no predicate at all.
+func AnyOf[T any](fs ...func(T) bool) func(T) bool {
+	return func(t T) bool {
+		for _, f := range fs {
+			if f(t) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NoneOf combines predicates, holding when none of them holds.
+func NoneOf[T any](fs ...func(T) bool) func(T) bool {
+	return Not(AnyOf(fs...))
+}
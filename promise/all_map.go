@@ -0,0 +1,47 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+
+	A "github.com/eicc27/Gophunc/array"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// AllMap waits for all promises of a map, like All,
+// keeping every result associated with its key.
+// If any promise fails, it settles with the errors of all failed promises
+// joined, each prefixed by its key. The order of the errors is not specified.
+//
+// Example:
+//
+//	profiles := array.NewTypedMap[string, *promise.Promise[Profile]]()
+//	for _, id := range ids {
+//		profiles.Set(id, fetchProfile(id))
+//	}
+//	promise.AllMap(profiles).Await().IfOKThen(func(m *array.TypedMap[string, Profile]) {
+//		fmt.Println(m.Get("alice"))
+//	})
+func AllMap[K comparable, T any](m *A.TypedMap[K, *Promise[T]]) *Promise[*A.TypedMap[K, T]] {
+	// the map is read now rather than in the goroutine,
+	// so it could be changed by the caller afterwards
+	entries := m.Entries().ToArray()
+	return New(func() *R.Result[*A.TypedMap[K, T]] {
+		res := A.NewTypedMap[K, T]()
+		var errs []error
+		// the promises are already running, so awaiting them in turn
+		// takes as long as the slowest one.
+		for _, e := range entries {
+			r := e.Second.Await()
+			if r.IsError() {
+				errs = append(errs, fmt.Errorf("%v: %w", e.First, r.AsError()))
+				continue
+			}
+			res.Set(e.First, r.AsOK())
+		}
+		if err := errors.Join(errs...); err != nil {
+			return R.Error[*A.TypedMap[K, T]](err)
+		}
+		return R.OK(res)
+	})
+}
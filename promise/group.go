@@ -0,0 +1,99 @@
+package promise
+
+import (
+	"context"
+	"sync"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Group runs a collection of tasks as promises and waits for all of them,
+// in the same fashion as errgroup.
+// The first error returned by a task is kept as the error of the group.
+//
+// A zero Group is valid, has no limit on active tasks
+// and does not cancel on error.
+//
+// Example:
+//
+//	g, ctx := promise.GroupWithContext(context.Background())
+//	g.SetLimit(4)
+//	for _, url := range urls {
+//		url := url
+//		g.Go(func() error {
+//			return fetch(ctx, url)
+//		})
+//	}
+//	g.Wait().IfErrorThen(...)
+type Group struct {
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+	once   sync.Once
+	err    error
+}
+
+// GroupWithContext creates a new Group and a derived context.
+// The context is cancelled when any task of the group fails,
+// or when Wait returns, whichever occurs first.
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of active tasks in the group to at most n.
+// Go blocks until a task could be started without exceeding the limit.
+// A negative n means no limit.
+//
+// It must not be called while any task of the group is active.
+func (g *Group) SetLimit(n int) *Group {
+	if n < 0 {
+		g.sem = nil
+		return g
+	}
+	g.sem = make(chan struct{}, n)
+	return g
+}
+
+// Go starts f as a promise in the group.
+// A panic in f is recovered and counts as an error of the group.
+func (g *Group) Go(f func() error) *Group {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	p := New(func() *R.Result[struct{}] {
+		return R.New(struct{}{}, f())
+	})
+	go func() {
+		defer g.done()
+		p.Await().IfErrorThen(g.fail)
+	}()
+	return g
+}
+
+// Wait blocks until all tasks started by Go are settled,
+// and returns the first error among them, if any.
+func (g *Group) Wait() *R.Result[struct{}] {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+	return R.New(struct{}{}, g.err)
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func (g *Group) fail(err error) {
+	g.once.Do(func() {
+		g.err = err
+		if g.cancel != nil {
+			g.cancel(err)
+		}
+	})
+}
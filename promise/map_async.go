@@ -0,0 +1,99 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+
+	A "github.com/eicc27/Gophunc/array"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// MapAsync applies f to every element of a concurrently,
+// running at most concurrency tasks at the same time.
+// A non-positive concurrency means no limit.
+//
+// The results keep the order of the input array.
+// It fails fast: once any task fails, no more tasks are started
+// and the promise settles with that error immediately.
+//
+// Example:
+//
+//	users := promise.MapAsync(array.New(1, 2, 3), fetchUser, 2).Await()
+//
+// It lives in promise rather than array, as promise imports array.
+func MapAsync[T, U, V any](a *A.TypedArray[T, V], f func(T) *R.Result[U], concurrency int) *Promise[*A.TypedArray[U, any]] {
+	return mapAsync(a, f, concurrency, true)
+}
+
+// MapAsyncCollect is the same as MapAsync, except that a failed task
+// does not stop others. All tasks run to the end, and if any of them fails,
+// the errors are joined in the order of the input array.
+func MapAsyncCollect[T, U, V any](a *A.TypedArray[T, V], f func(T) *R.Result[U], concurrency int) *Promise[*A.TypedArray[U, any]] {
+	return mapAsync(a, f, concurrency, false)
+}
+
+func mapAsync[T, U, V any](a *A.TypedArray[T, V], f func(T) *R.Result[U], concurrency int, failFast bool) *Promise[*A.TypedArray[U, any]] {
+	items := a.ToArray()
+	if concurrency <= 0 || concurrency > len(items) {
+		concurrency = max(len(items), 1)
+	}
+	return New(func() *R.Result[*A.TypedArray[U, any]] {
+		results := make([]U, len(items))
+		errs := make([]error, len(items))
+		sem := make(chan struct{}, concurrency)
+		failed := make(chan struct{})
+		var firstErr error
+		var once sync.Once
+		var wg sync.WaitGroup
+
+	dispatch:
+		for i, v := range items {
+			select {
+			case sem <- struct{}{}:
+			case <-failed:
+				break dispatch
+			}
+			wg.Add(1)
+			go func(i int, v T) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				// runs f as a promise so that a panic is turned into an error
+				r := New(func() *R.Result[U] {
+					return f(v)
+				}).Await()
+				if r.IsOK() {
+					results[i] = r.AsOK()
+					return
+				}
+				errs[i] = r.AsError()
+				if failFast {
+					once.Do(func() {
+						firstErr = r.AsError()
+						close(failed)
+					})
+				}
+			}(i, v)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-failed:
+		}
+		// failed is closed before its task is done, so a failure is seen here
+		// even if all tasks are done too, and fail-fast always reports firstErr
+		select {
+		case <-failed:
+			return R.Error[*A.TypedArray[U, any]](firstErr)
+		default:
+		}
+		if err := errors.Join(errs...); err != nil {
+			return R.Error[*A.TypedArray[U, any]](err)
+		}
+		return R.OK(A.New(results...))
+	})
+}
@@ -2,17 +2,68 @@ package promise
 
 import (
 	"errors"
+	"fmt"
+	"runtime/debug"
 	"sync"
+	"time"
 
 	R "github.com/eicc27/Gophunc/result"
 )
 
+// ErrTimeout is the error a promise created by WithTimeout settles with
+// when the underlying promise does not settle in time.
+var ErrTimeout = errors.New("promise timed out")
+
+// PanicError is the error a Promise[T] settles with when its task panics.
+// Value is the value passed to panic, and Stack is the stack trace
+// of the goroutine at the time of the panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("promise task panicked: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// AggregateError is the error Any settles with when all promises fail.
+// It keeps the error of every promise, in the order of the promises.
+type AggregateError struct {
+	Errors []error
+}
+
+func (e *AggregateError) Error() string {
+	msg := "all promises failed"
+	for _, err := range e.Errors {
+		msg += "\n" + err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying errors, so that errors.Is and errors.As
+// could match any of them.
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
 // Ported from JavaScript and realized with channels and goroutines.
 // Once a promise is constructed, the task starts as a goroutine immediately,
 // and could not be interrupted or stopped from outside controls.
+//
+// The result is latched once the task settles, so a Promise[T] could be
+// awaited or chained any number of times.
+// A panic inside the task is recovered and settles the promise with a *PanicError.
 type Promise[T any] struct {
-	fulfill chan T
-	err     error
+	done   chan struct{}
+	result R.Result[T]
 }
 
 // New creates a new Promise[T] from a task function.
@@ -38,16 +89,16 @@ type Promise[T any] struct {
 //	promise.All(task(1, false), task(2, false)).Await() // 1, 2(t_2), 2(t_1), _, 3
 func New[T any](f func() *R.Result[T]) *Promise[T] {
 	p := &Promise[T]{
-		fulfill: make(chan T),
+		done: make(chan struct{}),
 	}
 	go func() {
-		r := f()
-		r.IfErrorThen(func(err error) {
-			p.err = err
-		}).IfOKThen(func(t T) {
-			p.fulfill <- t
-		})
-		close(p.fulfill)
+		defer close(p.done)
+		defer func() {
+			if v := recover(); v != nil {
+				p.result = *R.Error[T](&PanicError{Value: v, Stack: debug.Stack()})
+			}
+		}()
+		p.result = *f()
 	}()
 	return p
 }
@@ -77,58 +128,104 @@ func (p *Promise[T]) Catch(failFn func(error)) *Promise[T] {
 	})
 }
 
+// CatchThen recovers a failed Promise[T] by applying failFn to its error.
+// The result returned by failFn, either a fallback value or another error,
+// becomes the result of the new Promise[T].
+// If the Promise[T] is successful, the result is passed through unchanged.
+func (p *Promise[T]) CatchThen(failFn func(error) *R.Result[T]) *Promise[T] {
+	return New[T](func() *R.Result[T] {
+		r := p.Await()
+		if r.IsError() {
+			return failFn(r.AsError())
+		}
+		return r
+	})
+}
+
+// Finally applies f when a Promise[T] settles, no matter it succeeds or fails.
+// The result of the Promise[T] is passed through unchanged,
+// which makes it suitable for cleanups like releasing connections.
+func (p *Promise[T]) Finally(f func()) *Promise[T] {
+	return New[T](func() *R.Result[T] {
+		r := p.Await()
+		f()
+		return r
+	})
+}
+
+// ToChannel returns a channel that receives the result of a Promise[T]
+// once it settles, and is closed afterwards.
+// The channel is buffered, so the promise settles even if nobody receives.
+// This makes a Promise[T] usable in select statements.
+func (p *Promise[T]) ToChannel() <-chan R.Result[T] {
+	ch := make(chan R.Result[T], 1)
+	go func() {
+		ch <- *p.Await()
+		close(ch)
+	}()
+	return ch
+}
+
 // Await blocks the main goroutine and waits for the result of a Promise[T].
-// Note that await closes the channel of the Promise[T] after it is called.
+// It could be called any number of times, each returning a copy of the same result.
 func (p *Promise[T]) Await() *R.Result[T] {
-	res := <-p.fulfill
-	return R.New(res, p.err)
+	<-p.done
+	r := p.result
+	return &r
 }
 
 // All awaits for the results of multiple Promise[T]s,
 // no matter how the promise fulfills (success or error).
-// It returns a slice of results, or a slice of errors.
+// It returns a slice of results in the order of the promises,
+// or the errors joined in the same order.
 func All[T any](promises ...*Promise[T]) *Promise[[]T] {
 	return New(func() *R.Result[[]T] {
-		// uses waitgroup to wait for all promises to complete
+		// every goroutine writes to its own index, so no lock is needed,
+		// and the waitgroup makes the writes visible after Wait.
 		var wg sync.WaitGroup
-		res := make([]T, 0)
-		errs := make([]error, 0)
-		for _, promise := range promises {
+		res := make([]T, len(promises))
+		errs := make([]error, len(promises))
+		for i, promise := range promises {
 			wg.Add(1)
-			go func(p *Promise[T]) {
+			go func(i int, p *Promise[T]) {
 				defer wg.Done()
 				r := p.Await()
 				r.IfErrorThen(func(err error) {
-					errs = append(errs, err)
+					errs[i] = err
 				}).IfOKThen(func(result T) {
-					res = append(res, result)
+					res[i] = result
 				})
-			}(promise)
+			}(i, promise)
 		}
 		wg.Wait()
-		if len(errs) != 0 {
-			return R.Error[[]T](errors.Join(errs...))
+		if err := errors.Join(errs...); err != nil {
+			return R.Error[[]T](err)
 		}
 		return R.OK(res)
 	})
 }
 
 // Any waits for the first successful Promise[T].
-// If all promises fail, it returns an error.
+// If all promises fail, it returns an *AggregateError.
 func Any[T any](promises ...*Promise[T]) *Promise[T] {
 	return New(func() *R.Result[T] {
 		var wg sync.WaitGroup
-		resultChan := make(chan T)
+		// buffered so that promises fulfilling after the first one
+		// do not block their goroutines forever.
+		resultChan := make(chan T, len(promises))
+		errs := make([]error, len(promises))
 
-		for _, promise := range promises {
+		for i, promise := range promises {
 			wg.Add(1)
-			go func(p *Promise[T]) {
+			go func(i int, p *Promise[T]) {
 				defer wg.Done()
 				r := p.Await()
 				r.IfOKThen(func(t T) {
 					resultChan <- t
+				}).IfErrorThen(func(err error) {
+					errs[i] = err
 				})
-			}(promise)
+			}(i, promise)
 		}
 
 		// If none of the promise returns successfully, this coroutine
@@ -147,13 +244,44 @@ func Any[T any](promises ...*Promise[T]) *Promise[T] {
 		if result, ok := <-resultChan; ok {
 			return R.OK(result)
 		}
-		return R.Error[T](errors.New("all promises failed"))
+		return R.Error[T](&AggregateError{Errors: errs})
 	})
 }
 
-// Await waits for the result of a Promise[T]. Note that await closes
-// the channel of the Promise[T] after it is called.
+// Await waits for the result of a Promise[T].
+// It could be called any number of times, each returning a copy of the same result.
 func Await[T any](p *Promise[T]) *R.Result[T] {
-	res := <-p.fulfill
-	return R.New(res, p.err)
+	return p.Await()
+}
+
+// WithTimeout returns a Promise[T] that settles with the result of p,
+// or with ErrTimeout if p does not settle within d.
+//
+// The waiting goroutine exits as soon as either happens,
+// so a promise that never settles does not keep it alive.
+// It only waits for p to settle and never takes its result,
+// so p can still be awaited after a timeout.
+func WithTimeout[T any](p *Promise[T], d time.Duration) *Promise[T] {
+	return New(func() *R.Result[T] {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-p.done:
+			return p.Await()
+		case <-timer.C:
+			return R.Error[T](ErrTimeout)
+		}
+	})
+}
+
+// FromChannel creates a Promise[T] that fulfills with the first value
+// received from ch. If ch is closed before any value is sent,
+// the promise fails.
+func FromChannel[T any](ch <-chan T) *Promise[T] {
+	return New(func() *R.Result[T] {
+		if v, ok := <-ch; ok {
+			return R.OK(v)
+		}
+		return R.Error[T](errors.New("channel closed without a value"))
+	})
 }
@@ -0,0 +1,34 @@
+package promise
+
+import (
+	"context"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Promisify lifts a synchronous function returning (T, error)
+// into a function returning a Promise[T].
+// Each call of the returned function starts f in a new goroutine.
+//
+// Example:
+//
+//	stat := promise.Promisify(os.Stat)
+//	stat("go.mod").Then(...)
+func Promisify[A, T any](f func(A) (T, error)) func(A) *Promise[T] {
+	return func(a A) *Promise[T] {
+		return New(func() *R.Result[T] {
+			return R.New(f(a))
+		})
+	}
+}
+
+// PromisifyContext is the context-accepting version of Promisify.
+// The context is passed to f as is, so cancelling it only
+// stops the task if f respects the context.
+func PromisifyContext[A, T any](f func(context.Context, A) (T, error)) func(context.Context, A) *Promise[T] {
+	return func(ctx context.Context, a A) *Promise[T] {
+		return New(func() *R.Result[T] {
+			return R.New(f(ctx, a))
+		})
+	}
+}
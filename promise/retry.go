@@ -0,0 +1,84 @@
+package promise
+
+import (
+	"math/rand"
+	"time"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// BackoffStrategy computes how long to wait before the next retry.
+// attempt starts from 1, which is the delay after the first failure.
+type BackoffStrategy func(attempt int) time.Duration
+
+// ConstantBackoff waits the same duration d between every retry.
+func ConstantBackoff(d time.Duration) BackoffStrategy {
+	return func(_ int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff doubles the delay after every failure,
+// starting from base and capped at max.
+// A non-positive max means the delay is not capped.
+func ExponentialBackoff(base time.Duration, max time.Duration) BackoffStrategy {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			d *= 2
+			if max > 0 && d >= max {
+				return max
+			}
+		}
+		if max > 0 && d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// WithJitter randomizes the delay of a strategy to a value in [0, d),
+// known as "full jitter". This avoids retries of many callers
+// hitting the same service at the same time.
+func WithJitter(strategy BackoffStrategy) BackoffStrategy {
+	return func(attempt int) time.Duration {
+		d := strategy(attempt)
+		if d <= 0 {
+			return 0
+		}
+		return time.Duration(rand.Int63n(int64(d)))
+	}
+}
+
+// Retry runs the promise created by factory until it succeeds,
+// or fails for attempts times. Between two runs it waits for the
+// duration given by backoff. If backoff is nil, it retries immediately.
+//
+// A new promise is created for every attempt as a promise could not be restarted.
+// If all attempts fail, the error of the last attempt is returned.
+//
+// Example:
+//
+//	p := promise.Retry(3, promise.ExponentialBackoff(100*time.Millisecond, time.Second),
+//		func() *promise.Promise[int] {
+//			return promise.New(fetch)
+//		},
+//	)
+func Retry[T any](attempts int, backoff BackoffStrategy, factory func() *Promise[T]) *Promise[T] {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return New(func() *R.Result[T] {
+		var r *R.Result[T]
+		for i := 1; i <= attempts; i++ {
+			r = factory().Await()
+			if r.IsOK() || i == attempts {
+				break
+			}
+			if backoff != nil {
+				time.Sleep(backoff(i))
+			}
+		}
+		return r
+	})
+}
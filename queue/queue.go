@@ -0,0 +1,69 @@
+package queue
+
+import O "github.com/eicc27/Gophunc/optional"
+
+// Queue is a FIFO queue backed by a growable ring buffer,
+// so both Enqueue and Dequeue take amortized O(1).
+type Queue[T any] struct {
+	items []T
+	head  int
+	size  int
+}
+
+// New creates a new Queue with some items, the first of which
+// is dequeued first.
+func New[T any](items ...T) *Queue[T] {
+	q := &Queue[T]{}
+	return q.Enqueue(items...)
+}
+
+// Enqueue adds items at the back of the Queue.
+func (q *Queue[T]) Enqueue(items ...T) *Queue[T] {
+	for _, v := range items {
+		if q.size == len(q.items) {
+			q.grow()
+		}
+		q.items[(q.head+q.size)%len(q.items)] = v
+		q.size++
+	}
+	return q
+}
+
+// Dequeue removes the item at the front of the Queue and returns it.
+// If the Queue is empty, it returns a nothing optional.
+func (q *Queue[T]) Dequeue() *O.Optional[T] {
+	if q.size == 0 {
+		return O.Nothing[T]()
+	}
+	v := q.items[q.head]
+	var zero T
+	// clears the slot so that the item could be garbage collected
+	q.items[q.head] = zero
+	q.head = (q.head + 1) % len(q.items)
+	q.size--
+	return O.Just(v)
+}
+
+// Peek returns the item at the front of the Queue without removing it.
+// If the Queue is empty, it returns a nothing optional.
+func (q *Queue[T]) Peek() *O.Optional[T] {
+	if q.size == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(q.items[q.head])
+}
+
+// Len returns the number of items in the Queue.
+func (q *Queue[T]) Len() int {
+	return q.size
+}
+
+// grow doubles the capacity, moving the items to the start of the buffer.
+func (q *Queue[T]) grow() {
+	items := make([]T, max(len(q.items)*2, 8))
+	for i := 0; i < q.size; i++ {
+		items[i] = q.items[(q.head+i)%len(q.items)]
+	}
+	q.items = items
+	q.head = 0
+}
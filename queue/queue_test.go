@@ -0,0 +1,26 @@
+package queue
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	q := New(1, 2)
+	for i := 3; i <= 100; i++ {
+		q.Enqueue(i)
+		// dequeues along the way so the buffer wraps around
+		if i%3 == 0 {
+			q.Dequeue()
+		}
+	}
+	want := 34
+	if got := q.Peek(); !got.IsSet() || got.Value() != want {
+		t.Fatalf("Peek = %v, want %d", got, want)
+	}
+	for ; want <= 100; want++ {
+		if got := q.Dequeue(); !got.IsSet() || got.Value() != want {
+			t.Fatalf("Dequeue = %v, want %d", got, want)
+		}
+	}
+	if q.Len() != 0 || q.Dequeue().IsSet() || q.Peek().IsSet() {
+		t.Error("a drained queue should be empty")
+	}
+}
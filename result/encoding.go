@@ -0,0 +1,85 @@
+package result
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// GobEncode implements gob.GobEncoder, so a Result[T]
+// could be persisted or sent over RPC with encoding/gob.
+//
+// Errors are arbitrary interface values gob could not encode in general,
+// so only the message of an error is kept. A decoded error is
+// created by errors.New, and could not be matched with errors.Is or errors.As.
+// An error result without an error, like the zero Result[T],
+// is decoded as the zero Result[T].
+func (r Result[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(r.IsError()); err != nil {
+		return nil, err
+	}
+	var err error
+	if r.IsError() {
+		err = encodeError(enc, r.AsError())
+	} else {
+		// the Optional encoding handles a nil value
+		err = enc.Encode(r.Right)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *Result[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var isError bool
+	if err := dec.Decode(&isError); err != nil {
+		return err
+	}
+	if isError {
+		var hasError bool
+		if err := dec.Decode(&hasError); err != nil {
+			return err
+		}
+		if !hasError {
+			*r = Result[T]{}
+			return nil
+		}
+		var message string
+		if err := dec.Decode(&message); err != nil {
+			return err
+		}
+		*r = *Error[T](errors.New(message))
+		return nil
+	}
+	var value O.Optional[T]
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+	*r = *OK(value.Value())
+	return nil
+}
+
+// encodeError encodes whether err is nil, followed by its message if not.
+func encodeError(enc *gob.Encoder, err error) error {
+	if e := enc.Encode(err != nil); e != nil || err == nil {
+		return e
+	}
+	return enc.Encode(err.Error())
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the gob encoding.
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	return r.GobEncode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler with the gob encoding.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	return r.GobDecode(data)
+}
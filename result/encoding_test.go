@@ -0,0 +1,43 @@
+package result
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func roundTrip[T any](t *testing.T, r Result[T]) Result[T] {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	var decoded Result[T]
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+	return decoded
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	if got := roundTrip(t, *OK(42)); !got.IsOK() || got.AsOK() != 42 {
+		t.Errorf("OK(42) decoded as %v", got)
+	}
+	got := roundTrip(t, *Error[int](errors.New("failed")))
+	if !got.IsError() || got.AsError() == nil || got.AsError().Error() != "failed" {
+		t.Errorf("an error result decoded as %v", got)
+	}
+}
+
+func TestGobRoundTripZero(t *testing.T) {
+	if got := roundTrip(t, Result[int]{}); !got.IsError() || got.AsError() != nil {
+		t.Errorf("the zero Result decoded as %v", got)
+	}
+	if got := roundTrip(t, *Error[int](nil)); !got.IsError() || got.AsError() != nil {
+		t.Errorf("Error(nil) decoded as %v", got)
+	}
+	if got := roundTrip(t, *OK[*int](nil)); !got.IsOK() || got.AsOK() != nil {
+		t.Errorf("OK(nil) decoded as %v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package ringbuffer
+
+import A "github.com/eicc27/Gophunc/array"
+
+// RingBuffer keeps at most a fixed number of items, e.g. the last N log lines.
+// When it is full, Push either overwrites the oldest item
+// or rejects the new one, depending on the overwrite policy.
+type RingBuffer[T any] struct {
+	items     []T
+	head      int
+	size      int
+	overwrite bool
+}
+
+// New creates a new RingBuffer holding at most capacity items.
+// If overwrite is true, pushing into a full buffer drops the oldest item.
+// Otherwise, the pushed item is rejected.
+// A non-positive capacity is set to 1.
+//
+// Example:
+//
+//	last := ringbuffer.New[string](3, true)
+//	last.Push("a")
+//	last.Push("b")
+//	last.Push("c")
+//	last.Push("d")
+//	fmt.Println(last.ToTypedArray()) // b c d
+func New[T any](capacity int, overwrite bool) *RingBuffer[T] {
+	return &RingBuffer[T]{
+		items:     make([]T, max(capacity, 1)),
+		overwrite: overwrite,
+	}
+}
+
+// Push adds an item as the newest one.
+// It returns false if the buffer is full and does not overwrite.
+func (r *RingBuffer[T]) Push(item T) bool {
+	if r.size < len(r.items) {
+		r.items[(r.head+r.size)%len(r.items)] = item
+		r.size++
+		return true
+	}
+	if !r.overwrite {
+		return false
+	}
+	r.items[r.head] = item
+	r.head = (r.head + 1) % len(r.items)
+	return true
+}
+
+// Len returns the number of items in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of items in the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.items)
+}
+
+// Clear removes all items from the buffer.
+func (r *RingBuffer[T]) Clear() *RingBuffer[T] {
+	clear(r.items)
+	r.head, r.size = 0, 0
+	return r
+}
+
+// ToTypedArray takes a snapshot of the items, from the oldest to the newest.
+func (r *RingBuffer[T]) ToTypedArray() *A.TypedArray[T, any] {
+	items := make([]T, r.size)
+	for i := range items {
+		items[i] = r.items[(r.head+i)%len(r.items)]
+	}
+	return A.New(items...)
+}
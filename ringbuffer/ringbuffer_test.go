@@ -0,0 +1,39 @@
+package ringbuffer
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	r := New[string](3, true)
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		if !r.Push(s) {
+			t.Fatalf("Push(%q) rejected by an overwriting buffer", s)
+		}
+	}
+	if got := r.ToTypedArray().ToArray(); !slices.Equal(got, []string{"c", "d", "e"}) {
+		t.Errorf("ToTypedArray = %v", got)
+	}
+	if r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("Len, Cap = %d, %d, want 3, 3", r.Len(), r.Cap())
+	}
+	if r.Clear().Len() != 0 || r.ToTypedArray().Length() != 0 {
+		t.Error("Clear should empty the buffer")
+	}
+}
+
+func TestRingBufferReject(t *testing.T) {
+	r := New[int](2, false)
+	r.Push(1)
+	r.Push(2)
+	if r.Push(3) {
+		t.Error("Push into a full buffer should be rejected")
+	}
+	if got := r.ToTypedArray().ToArray(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("ToTypedArray = %v", got)
+	}
+	if New[int](0, false).Cap() != 1 {
+		t.Error("a non-positive capacity should be set to 1")
+	}
+}
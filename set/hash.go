@@ -0,0 +1,79 @@
+package set
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// hashOf hashes a single element, so that a == b implies
+// hashOf(a) == hashOf(b).
+func hashOf[T comparable](v T) uint64 {
+	h := fnv.New64a()
+	writeValue(h, reflect.ValueOf(&v).Elem())
+	return h.Sum64()
+}
+
+// writeValue writes the parts of v compared by == into h.
+// Unexported struct fields are read without Interface,
+// so they are hashed as well.
+func writeValue(h hash.Hash64, v reflect.Value) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		h.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		switch {
+		case f == 0:
+			// -0.0 == 0.0
+			writeUint(0)
+		case math.IsNaN(f):
+			// NaN is not equal to anything, so any hash is consistent
+			writeUint(math.Float64bits(math.NaN()))
+		default:
+			writeUint(math.Float64bits(f))
+		}
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(real(v.Complex()))
+		writeFloat(imag(v.Complex()))
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		h.Write([]byte(v.String()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		// interfaces are equal only if their dynamic types are
+		writeUint(1)
+		h.Write([]byte(v.Elem().Type().String()))
+		writeValue(h, v.Elem())
+	}
+}
@@ -0,0 +1,229 @@
+package set
+
+import "math/bits"
+
+// ImmutableSet is a persistent unique collection of elements.
+// Add and Delete never modify a set. Instead, they return a new set
+// sharing most of its structure with the original one,
+// so sets could be passed around freely without defensive copying.
+//
+// It is implemented as a hash array mapped trie, where an update
+// only copies the nodes on the path to the updated element.
+// Like Set, it does not ensure the order of elements.
+//
+// Example:
+//
+//	s1 := set.NewImmutable(1, 2)
+//	s2 := s1.Add(3)
+//	fmt.Println(s1.Len(), s2.Len()) // 2, 3
+type ImmutableSet[T comparable] struct {
+	root *hamtNode[T]
+	size int
+}
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// hamtNode is either a leaf holding the elements sharing the same hash,
+// or a branch holding up to 32 children indexed by a bitmap.
+type hamtNode[T comparable] struct {
+	bitmap   uint32
+	children []*hamtNode[T]
+	hash     uint64
+	values   []T
+}
+
+func (n *hamtNode[T]) isLeaf() bool {
+	return len(n.values) > 0
+}
+
+// NewImmutable creates a new ImmutableSet from an array.
+func NewImmutable[T comparable](items ...T) *ImmutableSet[T] {
+	s := &ImmutableSet[T]{}
+	for _, v := range items {
+		s = s.Add(v)
+	}
+	return s
+}
+
+// Add returns a new ImmutableSet with v added.
+// If v is already in the set, the set itself is returned.
+func (s *ImmutableSet[T]) Add(v T) *ImmutableSet[T] {
+	root, added := hamtInsert(s.root, hashOf(v), 0, v)
+	if !added {
+		return s
+	}
+	return &ImmutableSet[T]{root: root, size: s.size + 1}
+}
+
+// Delete returns a new ImmutableSet with v deleted.
+// If v is not in the set, the set itself is returned.
+func (s *ImmutableSet[T]) Delete(v T) *ImmutableSet[T] {
+	root, deleted := hamtDelete(s.root, hashOf(v), 0, v)
+	if !deleted {
+		return s
+	}
+	return &ImmutableSet[T]{root: root, size: s.size - 1}
+}
+
+// Has checks if an element is in an ImmutableSet.
+func (s *ImmutableSet[T]) Has(v T) bool {
+	h := hashOf(v)
+	n := s.root
+	for shift := uint(0); n != nil; shift += hamtBits {
+		if n.isLeaf() {
+			if n.hash != h {
+				return false
+			}
+			for _, x := range n.values {
+				if x == v {
+					return true
+				}
+			}
+			return false
+		}
+		bit := uint32(1) << ((h >> shift) & hamtMask)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+		n = n.children[bits.OnesCount32(n.bitmap&(bit-1))]
+	}
+	return false
+}
+
+// Len returns the number of elements in an ImmutableSet.
+func (s *ImmutableSet[T]) Len() int {
+	return s.size
+}
+
+// Keys returns all keys of an ImmutableSet.
+func (s *ImmutableSet[T]) Keys() []T {
+	keys := make([]T, 0, s.size)
+	var walk func(n *hamtNode[T])
+	walk = func(n *hamtNode[T]) {
+		if n == nil {
+			return
+		}
+		keys = append(keys, n.values...)
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	walk(s.root)
+	return keys
+}
+
+// ToSet copies the elements into a mutable Set.
+func (s *ImmutableSet[T]) ToSet() Set[T] {
+	return NewSetFrom(s.Keys())
+}
+
+func hamtInsert[T comparable](n *hamtNode[T], h uint64, shift uint, v T) (*hamtNode[T], bool) {
+	if n == nil {
+		return &hamtNode[T]{hash: h, values: []T{v}}, true
+	}
+	if n.isLeaf() {
+		if n.hash == h {
+			for _, x := range n.values {
+				if x == v {
+					return n, false
+				}
+			}
+			values := make([]T, len(n.values), len(n.values)+1)
+			copy(values, n.values)
+			return &hamtNode[T]{hash: h, values: append(values, v)}, true
+		}
+		return hamtMerge(n, &hamtNode[T]{hash: h, values: []T{v}}, shift), true
+	}
+	bit := uint32(1) << ((h >> shift) & hamtMask)
+	pos := bits.OnesCount32(n.bitmap & (bit - 1))
+	if n.bitmap&bit == 0 {
+		children := make([]*hamtNode[T], 0, len(n.children)+1)
+		children = append(children, n.children[:pos]...)
+		children = append(children, &hamtNode[T]{hash: h, values: []T{v}})
+		children = append(children, n.children[pos:]...)
+		return &hamtNode[T]{bitmap: n.bitmap | bit, children: children}, true
+	}
+	child, added := hamtInsert(n.children[pos], h, shift+hamtBits, v)
+	if !added {
+		return n, false
+	}
+	children := make([]*hamtNode[T], len(n.children))
+	copy(children, n.children)
+	children[pos] = child
+	return &hamtNode[T]{bitmap: n.bitmap, children: children}, true
+}
+
+// hamtMerge creates the branches needed to hold two leaves of different hashes.
+func hamtMerge[T comparable](a *hamtNode[T], b *hamtNode[T], shift uint) *hamtNode[T] {
+	ia, ib := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if ia == ib {
+		return &hamtNode[T]{
+			bitmap:   1 << ia,
+			children: []*hamtNode[T]{hamtMerge(a, b, shift+hamtBits)},
+		}
+	}
+	if ia > ib {
+		a, b = b, a
+	}
+	return &hamtNode[T]{
+		bitmap:   1<<ia | 1<<ib,
+		children: []*hamtNode[T]{a, b},
+	}
+}
+
+func hamtDelete[T comparable](n *hamtNode[T], h uint64, shift uint, v T) (*hamtNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	if n.isLeaf() {
+		if n.hash != h {
+			return n, false
+		}
+		for i, x := range n.values {
+			if x != v {
+				continue
+			}
+			if len(n.values) == 1 {
+				return nil, true
+			}
+			values := make([]T, 0, len(n.values)-1)
+			values = append(values, n.values[:i]...)
+			values = append(values, n.values[i+1:]...)
+			return &hamtNode[T]{hash: h, values: values}, true
+		}
+		return n, false
+	}
+	bit := uint32(1) << ((h >> shift) & hamtMask)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	pos := bits.OnesCount32(n.bitmap & (bit - 1))
+	child, deleted := hamtDelete(n.children[pos], h, shift+hamtBits, v)
+	if !deleted {
+		return n, false
+	}
+	if child != nil {
+		children := make([]*hamtNode[T], len(n.children))
+		copy(children, n.children)
+		children[pos] = child
+		return hamtCompact(&hamtNode[T]{bitmap: n.bitmap, children: children}), true
+	}
+	if len(n.children) == 1 {
+		return nil, true
+	}
+	children := make([]*hamtNode[T], 0, len(n.children)-1)
+	children = append(children, n.children[:pos]...)
+	children = append(children, n.children[pos+1:]...)
+	return hamtCompact(&hamtNode[T]{bitmap: n.bitmap &^ bit, children: children}), true
+}
+
+// hamtCompact replaces a branch having a single leaf with the leaf itself.
+func hamtCompact[T comparable](n *hamtNode[T]) *hamtNode[T] {
+	if len(n.children) == 1 && n.children[0].isLeaf() {
+		return n.children[0]
+	}
+	return n
+}
@@ -0,0 +1,84 @@
+package set
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestImmutableSet(t *testing.T) {
+	s1 := NewImmutable(1, 2, 3)
+	s2 := s1.Add(4).Add(2)
+	s3 := s2.Delete(1).Delete(100)
+	if s1.Len() != 3 || s2.Len() != 4 || s3.Len() != 3 {
+		t.Fatalf("lengths = %d, %d, %d, want 3, 4, 3", s1.Len(), s2.Len(), s3.Len())
+	}
+	// updates leave the original sets unchanged
+	if s1.Has(4) || !s2.Has(1) || s3.Has(1) || !s3.Has(4) {
+		t.Error("Add or Delete modified a previous version")
+	}
+	keys := s3.Keys()
+	slices.Sort(keys)
+	if want := []int{2, 3, 4}; !slices.Equal(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+	if s2.Add(1) != s2 || s2.Delete(100) != s2 {
+		t.Error("a no-op update should return the set itself")
+	}
+}
+
+func TestImmutableSetMany(t *testing.T) {
+	const n = 5000
+	s := NewImmutable[int]()
+	for i := 0; i < n; i++ {
+		s = s.Add(i)
+	}
+	if s.Len() != n {
+		t.Fatalf("Len = %d, want %d", s.Len(), n)
+	}
+	for i := 0; i < n; i += 2 {
+		s = s.Delete(i)
+	}
+	for i := 0; i < n; i++ {
+		if s.Has(i) != (i%2 == 1) {
+			t.Fatalf("Has(%d) = %v after deleting the even numbers", i, s.Has(i))
+		}
+	}
+	for i := 1; i < n; i += 2 {
+		s = s.Delete(i)
+	}
+	if s.Len() != 0 || s.root != nil {
+		t.Errorf("Len = %d after deleting everything, want an empty trie", s.Len())
+	}
+}
+
+// TestHamtCollision forces elements with the same hash into one leaf.
+func TestHamtCollision(t *testing.T) {
+	const h = 42
+	root, _ := hamtInsert[string](nil, h, 0, "a")
+	root, _ = hamtInsert(root, h, 0, "b")
+	root, _ = hamtInsert(root, h+1, 0, "c")
+	if _, added := hamtInsert(root, h, 0, "b"); added {
+		t.Error("inserting an element twice should not add it")
+	}
+	root, deleted := hamtDelete(root, h, 0, "a")
+	if !deleted {
+		t.Fatal("deleting a colliding element failed")
+	}
+	s := &ImmutableSet[string]{root: root, size: 2}
+	keys := s.Keys()
+	slices.Sort(keys)
+	if want := []string{"b", "c"}; !slices.Equal(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+}
+
+func TestImmutableSetNegativeZero(t *testing.T) {
+	s := NewImmutable(0.0, math.Copysign(0, -1))
+	if s.Len() != 1 {
+		t.Errorf("Len = %d, want 1 as 0.0 == -0.0", s.Len())
+	}
+	if !s.Has(math.Copysign(0, -1)) {
+		t.Error("Has(-0.0) = false, want true")
+	}
+}
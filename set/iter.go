@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package set
+
+import (
+	"iter"
+	"slices"
+)
+
+// Iter returns an iterator over the elements of a Set,
+// so it could be ranged over with `for v := range s.Iter()`.
+// The order is not specified.
+func (s Set[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq collects the values of an iterator into a Set.
+func FromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	s := make(Set[T])
+	for v := range seq {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// Iter returns an iterator over the elements of a SortedSet in ascending order.
+func (s *SortedSet[T]) Iter() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// Iter returns an iterator over the elements of an ImmutableSet.
+// The order is not specified.
+func (s *ImmutableSet[T]) Iter() iter.Seq[T] {
+	return slices.Values(s.Keys())
+}
@@ -1,5 +1,11 @@
 package set
 
+import (
+	"maps"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
 // Set is a unique collection of elements.
 // It uses the uniqueness of keys in Go maps.
 type Set[T comparable] map[T]struct{}
@@ -27,6 +33,11 @@ func NewSetFrom[T comparable](items []T) Set[T] {
 	return s
 }
 
+// Empty creates an empty Set.
+func Empty[T comparable]() Set[T] {
+	return make(Set[T])
+}
+
 // Add adds an element to a Set.
 func (s Set[T]) Add(v T) {
 	s[v] = struct{}{}
@@ -43,6 +54,47 @@ func (s Set[T]) Has(v T) bool {
 	return ok
 }
 
+// Len returns the number of elements in a Set
+// without allocating the keys.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// IsEmpty checks if a Set has no element.
+func (s Set[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// NonEmpty checks if a Set has at least one element.
+func (s Set[T]) NonEmpty() bool {
+	return len(s) > 0
+}
+
+// Clear deletes all elements from a Set.
+func (s Set[T]) Clear() {
+	clear(s)
+}
+
+// Clone returns a shallow copy of a Set.
+// Adding to or deleting from the copy does not affect the original Set.
+func (s Set[T]) Clone() Set[T] {
+	c := maps.Clone(s)
+	if c == nil {
+		return New[T]()
+	}
+	return c
+}
+
+// Pop deletes an arbitrary element from a Set and returns it.
+// If the Set is empty, it returns a nothing optional.
+func (s Set[T]) Pop() *O.Optional[T] {
+	for k := range s {
+		delete(s, k)
+		return O.Just(k)
+	}
+	return O.Nothing[T]()
+}
+
 // Keys returns all keys of a Set.
 func (s Set[T]) Keys() []T {
 	keys := make([]T, 0)
@@ -51,3 +103,55 @@ func (s Set[T]) Keys() []T {
 	}
 	return keys
 }
+
+// IsSubsetOf checks if every element of s is in other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for k := range s {
+		if !other.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if every element of other is in s.
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjointFrom checks if s and other have no element in common.
+func (s Set[T]) IsDisjointFrom(other Set[T]) bool {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for k := range small {
+		if large.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if s and other have exactly the same elements.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubsetOf(other)
+}
+
+// Hash returns a hash of the elements of a Set.
+// It does not depend on the order of elements, so equal sets
+// always have the same hash, even across different runs.
+// Equal elements always have the same hash: elements are hashed
+// by the values they are compared by with ==, so 0.0 and -0.0 hash the same,
+// and pointers and channels are hashed by address.
+func (s Set[T]) Hash() uint64 {
+	var sum uint64
+	for k := range s {
+		// addition is commutative, which makes the hash order-independent
+		sum += hashOf(k)
+	}
+	return sum
+}
@@ -0,0 +1,35 @@
+package set
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHashEqualElements(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	if New(0.0).Hash() != New(negZero).Hash() {
+		t.Error("0.0 and -0.0 are equal but hash differently")
+	}
+	if New[any](0.0).Hash() != New[any](negZero).Hash() {
+		t.Error("0.0 and -0.0 in interfaces are equal but hash differently")
+	}
+	type point struct {
+		x, y float64
+		name string
+	}
+	if New(point{0, 1, "a"}).Hash() != New(point{negZero, 1, "a"}).Hash() {
+		t.Error("equal structs hash differently")
+	}
+}
+
+func TestHashOrderIndependent(t *testing.T) {
+	if New(1, 2, 3).Hash() != New(3, 1, 2).Hash() {
+		t.Error("equal sets hash differently")
+	}
+	if New("a", "b").Hash() == New("ab").Hash() {
+		t.Error("different sets should not collide trivially")
+	}
+	if New[any](1).Hash() == New[any](int64(1)).Hash() {
+		t.Error("interfaces of different types should hash differently")
+	}
+}
@@ -0,0 +1,91 @@
+package set
+
+import (
+	"cmp"
+	"slices"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// SortedSet is a unique collection of ordered elements.
+// Different from Set, it keeps its elements sorted in ascending order,
+// so the iteration order is deterministic.
+//
+// It is backed by a sorted slice: lookups take O(log n),
+// while insertions and deletions take O(n).
+type SortedSet[T cmp.Ordered] struct {
+	items []T
+}
+
+// NewSorted creates a new SortedSet from an array.
+// Example:
+//
+//	s := set.NewSorted(3, 1, 2)
+//	s.Add(3)
+//	fmt.Println(s.Keys()) // 1, 2, 3
+func NewSorted[T cmp.Ordered](items ...T) *SortedSet[T] {
+	sorted := slices.Clone(items)
+	slices.Sort(sorted)
+	return &SortedSet[T]{
+		items: slices.Compact(sorted),
+	}
+}
+
+// Add adds an element to a SortedSet.
+func (s *SortedSet[T]) Add(v T) *SortedSet[T] {
+	if i, found := slices.BinarySearch(s.items, v); !found {
+		s.items = slices.Insert(s.items, i, v)
+	}
+	return s
+}
+
+// Delete deletes an element from a SortedSet.
+func (s *SortedSet[T]) Delete(v T) *SortedSet[T] {
+	if i, found := slices.BinarySearch(s.items, v); found {
+		s.items = slices.Delete(s.items, i, i+1)
+	}
+	return s
+}
+
+// Has checks if an element is in a SortedSet.
+func (s *SortedSet[T]) Has(v T) bool {
+	_, found := slices.BinarySearch(s.items, v)
+	return found
+}
+
+// Len returns the number of elements in a SortedSet.
+func (s *SortedSet[T]) Len() int {
+	return len(s.items)
+}
+
+// Keys returns all keys of a SortedSet in ascending order.
+func (s *SortedSet[T]) Keys() []T {
+	return slices.Clone(s.items)
+}
+
+// Min returns the smallest element, or nothing if the set is empty.
+func (s *SortedSet[T]) Min() *O.Optional[T] {
+	if len(s.items) == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(s.items[0])
+}
+
+// Max returns the largest element, or nothing if the set is empty.
+func (s *SortedSet[T]) Max() *O.Optional[T] {
+	if len(s.items) == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(s.items[len(s.items)-1])
+}
+
+// Range returns the elements in [from, to) in ascending order.
+// If from is not less than to, it returns an empty array.
+func (s *SortedSet[T]) Range(from T, to T) []T {
+	if from >= to {
+		return make([]T, 0)
+	}
+	start, _ := slices.BinarySearch(s.items, from)
+	end, _ := slices.BinarySearch(s.items, to)
+	return slices.Clone(s.items[start:end])
+}
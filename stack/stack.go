@@ -0,0 +1,62 @@
+package stack
+
+import (
+	"slices"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Stack is a LIFO stack backed by a slice.
+type Stack[T any] struct {
+	items []T
+}
+
+// New creates a new Stack with some items, the last of which
+// is on the top.
+func New[T any](items ...T) *Stack[T] {
+	return &Stack[T]{
+		items: slices.Clone(items),
+	}
+}
+
+// Push pushes items onto the Stack, the last of which ends on the top.
+func (s *Stack[T]) Push(items ...T) *Stack[T] {
+	s.items = append(s.items, items...)
+	return s
+}
+
+// Pop removes the item on the top of the Stack and returns it.
+// If the Stack is empty, it returns a nothing optional.
+func (s *Stack[T]) Pop() *O.Optional[T] {
+	if len(s.items) == 0 {
+		return O.Nothing[T]()
+	}
+	last := len(s.items) - 1
+	v := s.items[last]
+	var zero T
+	// clears the slot so that the item could be garbage collected
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return O.Just(v)
+}
+
+// Peek returns the item on the top of the Stack without removing it.
+// If the Stack is empty, it returns a nothing optional.
+func (s *Stack[T]) Peek() *O.Optional[T] {
+	if len(s.items) == 0 {
+		return O.Nothing[T]()
+	}
+	return O.Just(s.items[len(s.items)-1])
+}
+
+// Len returns the number of items in the Stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// ToTypedArray copies the items into a TypedArray,
+// from the bottom to the top of the Stack.
+func (s *Stack[T]) ToTypedArray() *A.TypedArray[T, any] {
+	return A.New(slices.Clone(s.items)...)
+}
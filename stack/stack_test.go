@@ -0,0 +1,24 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := New(1, 2).Push(3)
+	if got := s.Peek(); !got.IsSet() || got.Value() != 3 {
+		t.Fatalf("Peek = %v, want 3", got)
+	}
+	if got := s.ToTypedArray().ToArray(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("ToTypedArray = %v", got)
+	}
+	for want := 3; want >= 1; want-- {
+		if got := s.Pop(); !got.IsSet() || got.Value() != want {
+			t.Fatalf("Pop = %v, want %d", got, want)
+		}
+	}
+	if s.Len() != 0 || s.Pop().IsSet() || s.Peek().IsSet() {
+		t.Error("a drained stack should be empty")
+	}
+}
@@ -0,0 +1,61 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"iter"
+
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Iter returns an iterator pulling the values of the Stream,
+// so it could be ranged over with `for v := range s.Iter()`.
+// Breaking out of the loop leaves the rest of the stream unconsumed.
+func (s *Stream[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := s.next(); v.IsSet(); v = s.next() {
+			if !yield(v.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a Stream pulling from an iterator, and a function
+// stopping it, like iter.Pull.
+// The iterator runs in its own goroutine once the stream is first pulled,
+// which only exits when the stream ends or stop is called,
+// so stop must be called if the stream is not drained to its end.
+// Calling stop more than once, or after the stream ends, has no effect,
+// and the stream ends once stop is called.
+//
+// Example:
+//
+//	s, stop := stream.FromSeq(maps.Keys(m))
+//	defer stop()
+//	first := s.Take(3).ToArray()
+func FromSeq[T any](seq iter.Seq[T]) (*Stream[T], func()) {
+	var next func() (T, bool)
+	var stopPull func()
+	done := false
+	stop := func() {
+		done = true
+		if stopPull != nil {
+			stopPull()
+		}
+	}
+	return New(func() *O.Optional[T] {
+		if done {
+			return O.Nothing[T]()
+		}
+		if next == nil {
+			next, stopPull = iter.Pull(seq)
+		}
+		v, ok := next()
+		if !ok {
+			stop()
+			return O.Nothing[T]()
+		}
+		return O.Just(v)
+	}), stop
+}
@@ -0,0 +1,57 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// FromJSONArray creates a Stream decoding the elements of a JSON array from r
+// one at a time, so a large payload is never held in memory as a whole.
+// Each element is delivered as an OK result. A malformed input is delivered
+// as a final error result, after which the stream ends.
+//
+// Example:
+//
+//	users := stream.FromJSONArray[User](resp.Body)
+//	users.ForEach(func(r result.Result[User]) {
+//		r.IfOKThen(save)
+//	})
+func FromJSONArray[T any](r io.Reader) *Stream[R.Result[T]] {
+	dec := json.NewDecoder(r)
+	started, done := false, false
+	fail := func(err error) *O.Optional[R.Result[T]] {
+		done = true
+		return O.Just(*R.Error[T](err))
+	}
+	return New(func() *O.Optional[R.Result[T]] {
+		if done {
+			return O.Nothing[R.Result[T]]()
+		}
+		if !started {
+			started = true
+			tok, err := dec.Token()
+			if err != nil {
+				return fail(err)
+			}
+			if tok != json.Delim('[') {
+				return fail(fmt.Errorf("stream: expected JSON array, got %v", tok))
+			}
+		}
+		if !dec.More() {
+			done = true
+			if _, err := dec.Token(); err != nil {
+				return fail(err)
+			}
+			return O.Nothing[R.Result[T]]()
+		}
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return fail(err)
+		}
+		return O.Just(*R.OK(v))
+	})
+}
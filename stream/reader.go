@@ -0,0 +1,65 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// Lines creates a Stream reading the lines of r lazily,
+// without the trailing "\n" or "\r\n".
+// Each line is delivered as an OK result. A read error is delivered
+// as a final error result, after which the stream ends.
+//
+// Example:
+//
+//	matches := stream.Lines(file).Filter(func(r result.Result[string]) bool {
+//		return r.IsError() || strings.Contains(r.AsOK(), "ERROR")
+//	})
+func Lines(r io.Reader) *Stream[R.Result[string]] {
+	return scan(r, bufio.ScanLines)
+}
+
+// Split creates a Stream reading the records of r separated by delim lazily.
+// Like Lines, errors are delivered as a final error result.
+// An empty delim splits r into UTF-8 characters.
+func Split(r io.Reader, delim string) *Stream[R.Result[string]] {
+	if delim == "" {
+		return scan(r, bufio.ScanRunes)
+	}
+	sep := []byte(delim)
+	return scan(r, func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.Index(data, sep); i >= 0 {
+			return i + len(sep), data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+}
+
+func scan(r io.Reader, split bufio.SplitFunc) *Stream[R.Result[string]] {
+	scanner := bufio.NewScanner(r)
+	// records are not limited to the default 64KB
+	scanner.Buffer(nil, math.MaxInt32)
+	scanner.Split(split)
+	done := false
+	return New(func() *O.Optional[R.Result[string]] {
+		if done {
+			return O.Nothing[R.Result[string]]()
+		}
+		if scanner.Scan() {
+			return O.Just(*R.OK(scanner.Text()))
+		}
+		done = true
+		if err := scanner.Err(); err != nil {
+			return O.Just(*R.Error[string](err))
+		}
+		return O.Nothing[R.Result[string]]()
+	})
+}
@@ -0,0 +1,99 @@
+package stream
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+
+	A "github.com/eicc27/Gophunc/array"
+)
+
+// Reservoir keeps a uniform random sample of at most n values
+// out of all the values added to it, however many they are,
+// with O(n) memory (reservoir sampling).
+// It is safe for concurrent use, so a goroutine could feed it
+// while others take snapshots.
+//
+// Example:
+//
+//	r := stream.NewReservoir[Request](100, nil)
+//	go r.Feed(requests)
+//	...
+//	sample := r.Snapshot()
+type Reservoir[T any] struct {
+	mu    sync.Mutex
+	n     int
+	rng   *rand.Rand
+	seen  int
+	items []T
+}
+
+// NewReservoir creates a Reservoir keeping a sample of at most n values.
+// A nil rng uses the default source of math/rand.
+func NewReservoir[T any](n int, rng *rand.Rand) *Reservoir[T] {
+	return &Reservoir[T]{
+		n:     max(n, 0),
+		rng:   rng,
+		items: make([]T, 0, max(n, 0)),
+	}
+}
+
+// Add offers values to the sample. After k values are added,
+// each of them is in the sample with the same probability n/k.
+func (r *Reservoir[T]) Add(items ...T) *Reservoir[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range items {
+		r.seen++
+		if len(r.items) < r.n {
+			r.items = append(r.items, v)
+			continue
+		}
+		if j := r.intn(r.seen); j < r.n {
+			r.items[j] = v
+		}
+	}
+	return r
+}
+
+// Feed adds every value received from ch until it is closed.
+// It blocks, so it is usually run in its own goroutine.
+func (r *Reservoir[T]) Feed(ch <-chan T) {
+	for v := range ch {
+		r.Add(v)
+	}
+}
+
+// Seen returns the number of values added so far.
+func (r *Reservoir[T]) Seen() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.seen
+}
+
+// Snapshot returns a copy of the current sample.
+// The order of the values is not specified.
+func (r *Reservoir[T]) Snapshot() *A.TypedArray[T, any] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return A.NewFrom(slices.Clone(r.items))
+}
+
+func (r *Reservoir[T]) intn(n int) int {
+	if r.rng == nil {
+		return rand.Intn(n)
+	}
+	return r.rng.Intn(n)
+}
+
+// Sample pulls every value of the Stream and keeps a uniform random sample
+// of at most n of them, using a Reservoir.
+// It never returns on an infinite stream, so limit it with Take first,
+// or feed a Reservoir instead.
+func (s *Stream[T]) Sample(n int, rng *rand.Rand) *A.TypedArray[T, any] {
+	r := NewReservoir[T](n, rng)
+	s.ForEach(func(v T) {
+		r.Add(v)
+	})
+	return r.Snapshot()
+}
@@ -0,0 +1,232 @@
+package stream
+
+import (
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+)
+
+// Stream is a lazy sequence of values, possibly infinite.
+// Different from TypedArray, values are only computed when they are pulled,
+// either by Next or by a terminal operation like ToArray.
+//
+// A Stream could be consumed only once. Operations like Map and Filter
+// return new streams pulling from the original one.
+//
+// Example:
+//
+//	evens := stream.Filter(stream.Iterate(0, func(i int) int { return i + 1 }),
+//		func(i int) bool { return i%2 == 0 },
+//	).Take(3).ToArray() // 0, 2, 4
+type Stream[T any] struct {
+	next func() *O.Optional[T]
+}
+
+// New creates a Stream from a pull function,
+// which returns a nothing optional once the stream ends.
+func New[T any](next func() *O.Optional[T]) *Stream[T] {
+	return &Stream[T]{
+		next: next,
+	}
+}
+
+// Of creates a finite Stream from some items.
+func Of[T any](items ...T) *Stream[T] {
+	i := 0
+	return New(func() *O.Optional[T] {
+		if i >= len(items) {
+			return O.Nothing[T]()
+		}
+		i++
+		return O.Just(items[i-1])
+	})
+}
+
+// Empty creates a Stream without any value.
+func Empty[T any]() *Stream[T] {
+	return New(O.Nothing[T])
+}
+
+// FromArray creates a finite Stream from the elements of a TypedArray.
+func FromArray[T, U any](a *A.TypedArray[T, U]) *Stream[T] {
+	return Of(a.ToArray()...)
+}
+
+// Generate unfolds a Stream from a seed.
+// next computes a value and the next state from the current state,
+// and the stream ends once it returns false.
+//
+// Example:
+//
+//	fib := stream.Generate([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+//		return s[0], [2]int{s[1], s[0] + s[1]}, true
+//	})
+//	fib.Take(6).ToArray() // 0, 1, 1, 2, 3, 5
+func Generate[S, T any](seed S, next func(S) (T, S, bool)) *Stream[T] {
+	state := seed
+	done := false
+	return New(func() *O.Optional[T] {
+		if done {
+			return O.Nothing[T]()
+		}
+		v, s, ok := next(state)
+		if !ok {
+			done = true
+			return O.Nothing[T]()
+		}
+		state = s
+		return O.Just(v)
+	})
+}
+
+// Iterate creates an infinite Stream of seed, f(seed), f(f(seed)), ...
+func Iterate[T any](seed T, f func(T) T) *Stream[T] {
+	return Generate(seed, func(s T) (T, T, bool) {
+		return s, f(s), true
+	})
+}
+
+// Repeat creates an infinite Stream of the same value.
+func Repeat[T any](value T) *Stream[T] {
+	return New(func() *O.Optional[T] {
+		return O.Just(value)
+	})
+}
+
+// Cycle creates an infinite Stream repeating some items in order.
+// If no item is given, the stream is empty.
+func Cycle[T any](items ...T) *Stream[T] {
+	i := 0
+	return New(func() *O.Optional[T] {
+		if len(items) == 0 {
+			return O.Nothing[T]()
+		}
+		v := items[i%len(items)]
+		i++
+		return O.Just(v)
+	})
+}
+
+// Next pulls the next value of the Stream.
+// It returns a nothing optional once the stream ends.
+func (s *Stream[T]) Next() *O.Optional[T] {
+	return s.next()
+}
+
+// IsEmpty checks if the Stream has no more value.
+// It pulls the next value to find out, which is then kept
+// and delivered by the next pull, so no value is lost.
+func (s *Stream[T]) IsEmpty() bool {
+	v := s.next()
+	if !v.IsSet() {
+		return true
+	}
+	pull := s.next
+	pulled := false
+	s.next = func() *O.Optional[T] {
+		if !pulled {
+			pulled = true
+			return v
+		}
+		return pull()
+	}
+	return false
+}
+
+// NonEmpty checks if the Stream has at least one more value.
+// Like IsEmpty, it keeps the value it pulls.
+func (s *Stream[T]) NonEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Take limits the Stream to its first n values.
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	taken := 0
+	return New(func() *O.Optional[T] {
+		if taken >= n {
+			return O.Nothing[T]()
+		}
+		taken++
+		return s.next()
+	})
+}
+
+// TakeWhile keeps the values of the Stream until f returns false.
+func (s *Stream[T]) TakeWhile(f func(T) bool) *Stream[T] {
+	done := false
+	return New(func() *O.Optional[T] {
+		if done {
+			return O.Nothing[T]()
+		}
+		v := s.next()
+		if !v.IsSet() || !f(v.Value()) {
+			done = true
+			return O.Nothing[T]()
+		}
+		return v
+	})
+}
+
+// Skip drops the first n values of the Stream.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	skipped := false
+	return New(func() *O.Optional[T] {
+		if !skipped {
+			skipped = true
+			for i := 0; i < n; i++ {
+				if !s.next().IsSet() {
+					return O.Nothing[T]()
+				}
+			}
+		}
+		return s.next()
+	})
+}
+
+// Filter keeps the values of the Stream that satisfy f.
+func (s *Stream[T]) Filter(f func(T) bool) *Stream[T] {
+	return New(func() *O.Optional[T] {
+		for {
+			v := s.next()
+			if !v.IsSet() || f(v.Value()) {
+				return v
+			}
+		}
+	})
+}
+
+// ForEach pulls every value of the Stream and applies f to it.
+// It never returns on an infinite stream.
+func (s *Stream[T]) ForEach(f func(T)) {
+	for v := s.next(); v.IsSet(); v = s.next() {
+		f(v.Value())
+	}
+}
+
+// ToArray pulls every value of the Stream into a TypedArray.
+// It never returns on an infinite stream, so limit it with Take first.
+func (s *Stream[T]) ToArray() *A.TypedArray[T, any] {
+	result := A.New[T]()
+	s.ForEach(func(t T) {
+		result.Push(t)
+	})
+	return result
+}
+
+// Map applies f to each value of a Stream lazily.
+// It is a top-level function since the type of values could change.
+func Map[T, U any](s *Stream[T], f func(T) U) *Stream[U] {
+	return New(func() *O.Optional[U] {
+		v := s.next()
+		if !v.IsSet() {
+			return O.Nothing[U]()
+		}
+		return O.Just(f(v.Value()))
+	})
+}
+
+// Filter keeps the values of a Stream that satisfy f.
+// It is the same as the Filter method, and reads better
+// when nested with Map.
+func Filter[T any](s *Stream[T], f func(T) bool) *Stream[T] {
+	return s.Filter(f)
+}
@@ -0,0 +1,98 @@
+package structs
+
+import (
+	"reflect"
+
+	A "github.com/eicc27/Gophunc/array"
+	"github.com/eicc27/Gophunc/tuple"
+)
+
+// Diff lists the exported fields whose values differ between a and b,
+// mapping the name of each field to the pair of its values in a and b.
+// Nested structs with exported fields are compared field by field,
+// with their fields named by dot paths like "Address.City" (see GetPath).
+// Other values, including structs like time.Time whose fields are all
+// unexported, are compared as a whole: with their Equal method
+// if they have one, else with reflect.DeepEqual.
+//
+// If a and b are not structs of the same type, returns an empty map.
+//
+// Example:
+//
+//	changes := structs.Diff(before, after)
+//	changes.ForEach(func(k string, v tuple.Pair[any, any]) {
+//		fmt.Printf("%s: %v -> %v\n", k, v.First, v.Second)
+//	})
+func Diff(a any, b any) *A.TypedMap[string, tuple.Pair[any, any]] {
+	changes := A.NewTypedMap[string, tuple.Pair[any, any]]()
+	va, okA := structValue(a)
+	vb, okB := structValue(b)
+	if !okA || !okB || va.Type() != vb.Type() {
+		return changes
+	}
+	visited := make(map[diffVisit]bool)
+	if va.CanAddr() && vb.CanAddr() {
+		visited[diffVisit{va.Addr().Pointer(), vb.Addr().Pointer(), va.Addr().Type()}] = true
+	}
+	diffValue(changes, "", va, vb, visited)
+	return changes
+}
+
+// diffVisit identifies a pair of pointers to structs already compared,
+// like visit does for Validate.
+type diffVisit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+// diffValue compares each pair of pointers to structs only once,
+// so self-referencing structs do not recurse forever.
+func diffValue(changes *A.TypedMap[string, tuple.Pair[any, any]], prefix string, a reflect.Value, b reflect.Value, visited map[diffVisit]bool) {
+	for i := 0; i < a.NumField(); i++ {
+		field := a.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fa, fb := a.Field(i), b.Field(i)
+		name := prefix + field.Name
+		if fa.Kind() == reflect.Pointer && !fa.IsNil() && !fb.IsNil() {
+			if fa.Elem().Kind() == reflect.Struct && hasExportedField(fa.Type().Elem()) {
+				key := diffVisit{fa.Pointer(), fb.Pointer(), fa.Type()}
+				if visited[key] {
+					continue
+				}
+				visited[key] = true
+			}
+			fa, fb = fa.Elem(), fb.Elem()
+		}
+		if fa.Kind() == reflect.Struct && hasExportedField(fa.Type()) {
+			diffValue(changes, name+".", fa, fb, visited)
+			continue
+		}
+		if !equalValue(fa, fb) {
+			changes.Set(name, tuple.NewPair(fa.Interface(), fb.Interface()))
+		}
+	}
+}
+
+func hasExportedField(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValue compares a and b with an Equal(T) bool method
+// when T has one, falling back to reflect.DeepEqual.
+func equalValue(a reflect.Value, b reflect.Value) bool {
+	if a.Type() == b.Type() {
+		if m, ok := a.Type().MethodByName("Equal"); ok &&
+			m.Type.NumIn() == 2 && m.Type.In(1) == a.Type() &&
+			m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Bool {
+			return m.Func.Call([]reflect.Value{a, b})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
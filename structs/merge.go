@@ -0,0 +1,70 @@
+package structs
+
+import (
+	"errors"
+	"reflect"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// MergeOptions controls how Merge copies fields.
+type MergeOptions struct {
+	// OverwriteWithZero copies zero-valued fields of src as well,
+	// overwriting the fields of dst.
+	OverwriteWithZero bool
+	// Deep merges nested structs (and pointers to structs) field by field,
+	// instead of copying them as a whole.
+	// A pointer to a struct in dst is replaced by a pointer to a merged copy,
+	// leaving the struct it pointed to unchanged.
+	Deep bool
+}
+
+// Merge copies the exported non-zero fields of src into dst.
+// dst must be a pointer to a struct, and src must be a struct
+// (or a pointer to a struct) of the same type.
+//
+// Example:
+//
+//	config := defaults
+//	structs.Merge(&config, fromFile, structs.MergeOptions{Deep: true})
+func Merge(dst any, src any, opts MergeOptions) *R.Result[struct{}] {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return R.Error[struct{}](errors.New("dst must be a non-nil pointer to a struct"))
+	}
+	s, ok := structValue(src)
+	if !ok {
+		return R.Error[struct{}](errors.New("src must be a struct"))
+	}
+	if s.Type() != d.Elem().Type() {
+		return R.Error[struct{}](errors.New("dst and src must be of the same type"))
+	}
+	mergeValue(d.Elem(), s, opts)
+	return R.OK(struct{}{})
+}
+
+func mergeValue(dst reflect.Value, src reflect.Value, opts MergeOptions) {
+	for i := 0; i < src.NumField(); i++ {
+		if !src.Type().Field(i).IsExported() {
+			continue
+		}
+		d, s := dst.Field(i), src.Field(i)
+		if opts.Deep && s.Kind() == reflect.Struct {
+			mergeValue(d, s, opts)
+			continue
+		}
+		if opts.Deep && s.Kind() == reflect.Pointer && s.Type().Elem().Kind() == reflect.Struct &&
+			!s.IsNil() && !d.IsNil() {
+			// merges into a copy, as the pointee could be shared with
+			// another struct, like the defaults dst was copied from
+			p := reflect.New(d.Type().Elem())
+			p.Elem().Set(d.Elem())
+			mergeValue(p.Elem(), s.Elem(), opts)
+			d.Set(p)
+			continue
+		}
+		if opts.OverwriteWithZero || !s.IsZero() {
+			d.Set(s)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// GetPath gets a value from the object with a dot path like "Server.Ports.0".
+// Each segment of the path is a field name for structs, a key for maps,
+// and an index for slices and arrays. Pointers and interfaces are followed.
+// If any segment could not be resolved, returns a nothing optional.
+//
+// Example:
+//
+//	port := structs.GetPath(config, "Servers.0.Port")
+func GetPath(object any, path string) *O.Optional[any] {
+	v := reflect.ValueOf(object)
+	for _, seg := range splitPath(path) {
+		v = indirect(v)
+		if !v.IsValid() {
+			return O.Nothing[any]()
+		}
+		next, err := child(v, seg)
+		if err != nil {
+			return O.Nothing[any]()
+		}
+		v = next
+	}
+	if !v.IsValid() || !v.CanInterface() {
+		return O.Nothing[any]()
+	}
+	return O.Just(v.Interface())
+}
+
+// SetPath sets a value of the object pointed by ptr with a dot path (see GetPath).
+// The value must be assignable to the target.
+// Nil pointers and maps on the path are allocated,
+// and missing map keys are created with zero values.
+//
+// Example:
+//
+//	structs.SetPath(&config, "Servers.0.Port", 8080)
+func SetPath(ptr any, path string, value any) *R.Result[struct{}] {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return R.Error[struct{}](errors.New("ptr must be a non-nil pointer"))
+	}
+	if err := setPath(v.Elem(), splitPath(path), reflect.ValueOf(value)); err != nil {
+		return R.Error[struct{}](err)
+	}
+	return R.OK(struct{}{})
+}
+
+func splitPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// indirect follows pointers and interfaces until a concrete value.
+// A nil pointer or interface results in an invalid value.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// child resolves one segment of a path in v.
+func child(v reflect.Value, seg string) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Struct:
+		field, ok := v.Type().FieldByName(seg)
+		if !ok || !field.IsExported() {
+			return reflect.Value{}, fmt.Errorf("no exported field %q", seg)
+		}
+		// a promoted field could be reached through a nil embedded pointer
+		f, err := v.FieldByIndexErr(field.Index)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("field %q: %w", seg, err)
+		}
+		return f, nil
+	case reflect.Map:
+		key, err := mapKey(v.Type().Key(), seg)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		elem := v.MapIndex(key)
+		if !elem.IsValid() {
+			return reflect.Value{}, fmt.Errorf("no key %q", seg)
+		}
+		return elem, nil
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 || i >= v.Len() {
+			return reflect.Value{}, fmt.Errorf("invalid index %q", seg)
+		}
+		return v.Index(i), nil
+	}
+	return reflect.Value{}, fmt.Errorf("could not resolve %q in %s", seg, v.Kind())
+}
+
+// mapKey converts a segment of a path to a key of the given type.
+func mapKey(t reflect.Type, seg string) (reflect.Value, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(seg).Convert(t), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(seg, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(i).Convert(t), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(seg, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(i).Convert(t), nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported map key type %s", t)
+}
+
+// assign sets value to v with type checks.
+// An invalid value (from a nil any) sets v to its zero value.
+func assign(v reflect.Value, value reflect.Value) error {
+	if !v.CanSet() {
+		return errors.New("value could not be set")
+	}
+	if !value.IsValid() {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if !value.Type().AssignableTo(v.Type()) {
+		return fmt.Errorf("%s is not assignable to %s", value.Type(), v.Type())
+	}
+	v.Set(value)
+	return nil
+}
+
+func setPath(v reflect.Value, segs []string, value reflect.Value) error {
+	if len(segs) == 0 {
+		return assign(v, value)
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			if !v.CanSet() {
+				return errors.New("nil pointer could not be allocated")
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setPath(v.Elem(), segs, value)
+	case reflect.Interface:
+		if v.IsNil() {
+			return errors.New("could not set through a nil interface")
+		}
+		// values inside an interface are not settable,
+		// so a copy is modified and stored back.
+		tmp := reflect.New(v.Elem().Type()).Elem()
+		tmp.Set(v.Elem())
+		if err := setPath(tmp, segs, value); err != nil {
+			return err
+		}
+		return assign(v, tmp)
+	case reflect.Map:
+		key, err := mapKey(v.Type().Key(), segs[0])
+		if err != nil {
+			return err
+		}
+		if v.IsNil() {
+			if !v.CanSet() {
+				return errors.New("nil map could not be allocated")
+			}
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		// map elements are not addressable either.
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if current := v.MapIndex(key); current.IsValid() {
+			elem.Set(current)
+		}
+		if err := setPath(elem, segs[1:], value); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, elem)
+		return nil
+	}
+	next, err := child(v, segs[0])
+	if err != nil {
+		return err
+	}
+	return setPath(next, segs[1:], value)
+}
@@ -1,9 +1,15 @@
 package structs
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 
 	A "github.com/eicc27/Gophunc/array"
+	"github.com/eicc27/Gophunc/internal/clone"
+	"github.com/eicc27/Gophunc/internal/fields"
+	R "github.com/eicc27/Gophunc/result"
+	"github.com/eicc27/Gophunc/set"
 )
 
 // Gets the keys of a field from a struct.
@@ -18,11 +24,209 @@ func Keys(object any) *A.TypedArray[string, any] {
 	})
 }
 
+// Gets the keys of a struct as named by the given struct tag, like json or db.
+// The name is the part of the tag before the first comma.
+// Fields without the tag are named by their field names,
+// and unexported fields and fields tagged with "-" are skipped.
+// If the object is not a struct, returns an empty array.
+//
+// Example:
+//
+//	type User struct {
+//		Name string `json:"name"`
+//		Age  int
+//	}
+//	fmt.Println(structs.KeysByTag(User{}, "json")) // name, Age
+func KeysByTag(object any, tag string) *A.TypedArray[string, any] {
+	values, ok := structValue(object)
+	if !ok {
+		return A.New[string]()
+	}
+	keys := A.New[string]()
+	for i := 0; i < values.NumField(); i++ {
+		field := values.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if name, ok := fields.TagName(field, tag); ok {
+			keys.Push(name)
+		}
+	}
+	return keys
+}
+
 // Gets a value from the object with given string key.
-func ValueOf(object any, key string) any {
+// If tags are given, key is first matched against the names
+// in these struct tags (see KeysByTag), and then the field names.
+// Only exported fields are matched. If no field matches, returns nil.
+func ValueOf(object any, key string, tags ...string) any {
 	if reflect.TypeOf(object).Kind() != reflect.Struct {
 		return nil
 	}
 	values := reflect.ValueOf(object)
-	return values.FieldByName(key).Interface()
+	for _, tag := range tags {
+		if i, ok := fields.ByTag(values.Type(), tag)[key]; ok {
+			return values.Field(i).Interface()
+		}
+	}
+	field, ok := values.Type().FieldByName(key)
+	if !ok || !field.IsExported() {
+		return nil
+	}
+	v, err := values.FieldByIndexErr(field.Index)
+	if err != nil {
+		return nil
+	}
+	return v.Interface()
+}
+
+// Sets a value of the struct pointed by ptr with given string key.
+// The field must be exported, and value must be assignable to it.
+//
+// Example:
+//
+//	structs.SetValue(&user, "Name", "Alice")
+func SetValue(ptr any, key string, value any) *R.Result[struct{}] {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return R.Error[struct{}](errors.New("ptr must be a non-nil pointer to a struct"))
+	}
+	field, ok := v.Elem().Type().FieldByName(key)
+	if !ok || !field.IsExported() {
+		return R.Error[struct{}](fmt.Errorf("no exported field %q", key))
+	}
+	// a promoted field could be reached through a nil embedded pointer
+	f, err := v.Elem().FieldByIndexErr(field.Index)
+	if err != nil {
+		return R.Error[struct{}](fmt.Errorf("field %q: %w", key, err))
+	}
+	if err := assign(f, reflect.ValueOf(value)); err != nil {
+		return R.Error[struct{}](err)
+	}
+	return R.OK(struct{}{})
+}
+
+// MapFields applies f to every exported field of a struct,
+// and returns a new struct with the results as the field values.
+// The struct passed in is not modified.
+// It fails if object is not a struct, or if a result of f
+// is not assignable to its field.
+//
+// Example:
+//
+//	trimmed := structs.MapFields(form, func(name string, value any) any {
+//		if s, ok := value.(string); ok {
+//			return strings.TrimSpace(s)
+//		}
+//		return value
+//	})
+func MapFields[T any](object T, f func(string, any) any) *R.Result[T] {
+	src := reflect.ValueOf(object)
+	if src.Kind() != reflect.Struct {
+		return R.Error[T](errors.New("object must be a struct"))
+	}
+	dst := reflect.New(src.Type()).Elem()
+	dst.Set(src)
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := f(field.Name, dst.Field(i).Interface())
+		if err := assign(dst.Field(i), reflect.ValueOf(value)); err != nil {
+			return R.Error[T](fmt.Errorf("%s: %w", field.Name, err))
+		}
+	}
+	return R.OK(dst.Interface().(T))
+}
+
+// ZeroFields gets the names of the exported fields holding zero values.
+// If the object is not a struct, returns an empty array.
+func ZeroFields(object any) *A.TypedArray[string, any] {
+	fields := A.New[string]()
+	values, ok := structValue(object)
+	if !ok {
+		return fields
+	}
+	for i := 0; i < values.NumField(); i++ {
+		if values.Type().Field(i).IsExported() && values.Field(i).IsZero() {
+			fields.Push(values.Type().Field(i).Name)
+		}
+	}
+	return fields
+}
+
+// IsComplete checks if no exported field of a struct holds a zero value.
+// If the object is not a struct, returns false.
+func IsComplete(object any) bool {
+	if _, ok := structValue(object); !ok {
+		return false
+	}
+	return ZeroFields(object).Length() == 0
+}
+
+// Pick gets the exported fields with given keys from a struct as a map.
+// Keys that are not fields of the struct are ignored.
+// If the object is not a struct, returns an empty map.
+//
+// Example:
+//
+//	m := structs.Pick(user, "Name", "Email")
+//	fmt.Println(m.Keys()) // Name, Email
+func Pick(object any, keys ...string) *A.TypedMap[string, any] {
+	picked := set.New(keys...)
+	return fieldsWhere(object, func(name string) bool {
+		return picked.Has(name)
+	})
+}
+
+// Omit gets all exported fields except the ones with given keys from a struct as a map.
+// If the object is not a struct, returns an empty map.
+func Omit(object any, keys ...string) *A.TypedMap[string, any] {
+	omitted := set.New(keys...)
+	return fieldsWhere(object, func(name string) bool {
+		return !omitted.Has(name)
+	})
+}
+
+// fieldsWhere collects the exported fields whose names satisfy f.
+func fieldsWhere(object any, f func(string) bool) *A.TypedMap[string, any] {
+	m := A.NewTypedMap[string, any]()
+	values, ok := structValue(object)
+	if !ok {
+		return m
+	}
+	for i := 0; i < values.NumField(); i++ {
+		field := values.Type().Field(i)
+		if field.IsExported() && f(field.Name) {
+			m.Set(field.Name, values.Field(i).Interface())
+		}
+	}
+	return m
+}
+
+// structValue gets the reflected struct from an object,
+// following pointers if any.
+func structValue(object any) (reflect.Value, bool) {
+	values := reflect.ValueOf(object)
+	for values.Kind() == reflect.Pointer {
+		if values.IsNil() {
+			return values, false
+		}
+		values = values.Elem()
+	}
+	return values, values.Kind() == reflect.Struct
+}
+
+// Clone returns a deep copy of v, which is usually a struct.
+// Maps, slices, arrays, pointers and exported fields of nested structs
+// are copied recursively, so changing the copy never affects v.
+// Unexported fields are copied shallowly.
+//
+// Example:
+//
+//	draft := structs.Clone(config)
+//	draft.Servers[0].Port = 8080 // config is untouched
+func Clone[T any](v T) T {
+	return clone.Deep(v)
 }
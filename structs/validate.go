@@ -0,0 +1,151 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	R "github.com/eicc27/Gophunc/result"
+)
+
+// FieldError describes a field of a struct that fails a validation rule.
+type FieldError struct {
+	// Field is the dot path of the field, like "Address.City".
+	Field string
+	// Rule is the name of the failed rule, like "min".
+	Rule string
+	// Param is the parameter of the rule, like "3" in "min=3".
+	Param string
+}
+
+func (e *FieldError) Error() string {
+	if e.Param == "" {
+		return fmt.Sprintf("%s: failed %s", e.Field, e.Rule)
+	}
+	return fmt.Sprintf("%s: failed %s=%s", e.Field, e.Rule, e.Param)
+}
+
+// Validate checks the exported fields of a struct against the rules
+// in their `validate` tags, separated by commas:
+//
+//	required    the field is not a zero value
+//	min=n       numbers are at least n, strings, slices and maps have at least n elements
+//	max=n       numbers are at most n, strings, slices and maps have at most n elements
+//	len=n       strings, slices and maps have exactly n elements
+//	oneof=a b c the field, formatted with %v, is one of the space-separated values
+//
+// Nested structs are validated as well. All failures are collected,
+// and the error of the result joins a *FieldError for each of them.
+// An unknown rule or a malformed parameter is reported as an error too.
+//
+// Example:
+//
+//	type User struct {
+//		Name string `validate:"required,min=3"`
+//		Role string `validate:"oneof=admin user"`
+//	}
+//	structs.Validate(User{Name: "Al"}).IfErrorThen(...)
+func Validate(object any) *R.Result[struct{}] {
+	v, ok := structValue(object)
+	if !ok {
+		return R.Error[struct{}](errors.New("object to validate must be a struct"))
+	}
+	errs := validateValue("", v, make(map[visit]bool))
+	if len(errs) != 0 {
+		return R.Error[struct{}](errors.Join(errs...))
+	}
+	return R.OK(struct{}{})
+}
+
+// visit identifies a struct reached through a pointer.
+// The type is part of the key, as a struct and its first field
+// share the same address.
+type visit struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// validateValue validates each struct reached through pointers only once,
+// so self-referencing structs do not recurse forever.
+func validateValue(prefix string, v reflect.Value, visited map[visit]bool) []error {
+	if v.CanAddr() {
+		key := visit{v.Addr().Pointer(), v.Type()}
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+	}
+	errs := make([]error, 0)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := prefix + field.Name
+		f := v.Field(i)
+		if tag, ok := field.Tag.Lookup("validate"); ok && tag != "" {
+			for _, rule := range strings.Split(tag, ",") {
+				if err := checkRule(name, f, rule); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+		if nested := indirect(f); nested.Kind() == reflect.Struct {
+			errs = append(errs, validateValue(name+".", nested, visited)...)
+		}
+	}
+	return errs
+}
+
+func checkRule(name string, v reflect.Value, rule string) error {
+	rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+	fail := &FieldError{Field: name, Rule: rule, Param: param}
+	switch rule {
+	case "required":
+		if v.IsZero() {
+			return fail
+		}
+		return nil
+	case "oneof":
+		s := fmt.Sprint(v.Interface())
+		for _, option := range strings.Fields(param) {
+			if s == option {
+				return nil
+			}
+		}
+		return fail
+	case "min", "max", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid parameter of %s: %w", name, rule, err)
+		}
+		size, ok := measure(v)
+		if !ok {
+			return fmt.Errorf("%s: %s is not supported for %s", name, rule, v.Kind())
+		}
+		if (rule == "min" && size < n) || (rule == "max" && size > n) || (rule == "len" && size != n) {
+			return fail
+		}
+		return nil
+	}
+	return fmt.Errorf("%s: unknown rule %q", name, rule)
+}
+
+// measure gets the value of a number, or the length of a collection.
+func measure(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		return float64(len([]rune(v.String()))), true
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return float64(v.Len()), true
+	}
+	return 0, false
+}
@@ -0,0 +1,123 @@
+package task
+
+import (
+	"context"
+	"sync"
+
+	P "github.com/eicc27/Gophunc/promise"
+	R "github.com/eicc27/Gophunc/result"
+	"github.com/eicc27/Gophunc/tuple"
+)
+
+// Task is a lazy, cancellable computation.
+// Different from a Promise, which starts as soon as it is created,
+// a Task does nothing until Run is called, and could be run many times.
+// The context passed to Run is handed to the computation for cancellation.
+//
+// Example:
+//
+//	t := task.New(func(ctx context.Context) *result.Result[User] {
+//		return fetchUser(ctx, id)
+//	}).Then(validate)
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	t.Run(ctx).IfOKThen(...)
+type Task[T any] struct {
+	run func(ctx context.Context) *R.Result[T]
+}
+
+// New creates a new Task from a function, which should respect
+// the cancellation of the context it is given.
+func New[T any](f func(ctx context.Context) *R.Result[T]) *Task[T] {
+	return &Task[T]{
+		run: f,
+	}
+}
+
+// Of creates a Task that succeeds with a value.
+func Of[T any](value T) *Task[T] {
+	return New(func(context.Context) *R.Result[T] {
+		return R.OK(value)
+	})
+}
+
+// FromPromise creates a Task awaiting a Promise.
+// As the promise has already started, running the Task only waits for it,
+// and cancelling the Task stops the waiting but not the promise.
+func FromPromise[T any](p *P.Promise[T]) *Task[T] {
+	return New(func(ctx context.Context) *R.Result[T] {
+		select {
+		case r := <-p.ToChannel():
+			return &r
+		case <-ctx.Done():
+			return R.Error[T](context.Cause(ctx))
+		}
+	})
+}
+
+// Run runs the Task and blocks until it finishes.
+// If ctx is already done, the Task is not started and the context error is returned.
+func (t *Task[T]) Run(ctx context.Context) *R.Result[T] {
+	if ctx.Err() != nil {
+		return R.Error[T](context.Cause(ctx))
+	}
+	return t.run(ctx)
+}
+
+// ToPromise runs the Task in a Promise, which starts immediately.
+func (t *Task[T]) ToPromise(ctx context.Context) *P.Promise[T] {
+	return P.New(func() *R.Result[T] {
+		return t.Run(ctx)
+	})
+}
+
+// Then creates a Task that applies successFn to the result of t if it is successful.
+// successFn is not called if the context is done after t finishes.
+func (t *Task[T]) Then(successFn func(T) *R.Result[T]) *Task[T] {
+	return New(func(ctx context.Context) *R.Result[T] {
+		r := t.Run(ctx)
+		if r.IsError() {
+			return r
+		}
+		if ctx.Err() != nil {
+			return R.Error[T](context.Cause(ctx))
+		}
+		return successFn(r.AsOK())
+	})
+}
+
+// Map creates a Task applying f to the result of t if it is successful.
+// It is a top-level function since the type of the result could change.
+func Map[T, U any](t *Task[T], f func(T) U) *Task[U] {
+	return New(func(ctx context.Context) *R.Result[U] {
+		r := t.Run(ctx)
+		if r.IsError() {
+			return R.Error[U](r.AsError())
+		}
+		return R.OK(f(r.AsOK()))
+	})
+}
+
+// Zip creates a Task running a and b concurrently, and pairing their results.
+// If either fails, the other is cancelled and the first error is returned.
+func Zip[A, B any](a *Task[A], b *Task[B]) *Task[tuple.Pair[A, B]] {
+	return New(func(ctx context.Context) *R.Result[tuple.Pair[A, B]] {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var once sync.Once
+		var firstErr error
+		fail := func(err error) {
+			once.Do(func() {
+				firstErr = err
+				cancel()
+			})
+		}
+		ra := a.ToPromise(ctx).Catch(fail)
+		rb := b.ToPromise(ctx).Catch(fail)
+		va, vb := ra.Await(), rb.Await()
+		if firstErr != nil {
+			return R.Error[tuple.Pair[A, B]](firstErr)
+		}
+		return R.OK(tuple.NewPair(va.AsOK(), vb.AsOK()))
+	})
+}
@@ -0,0 +1,54 @@
+package text
+
+import (
+	"strings"
+
+	A "github.com/eicc27/Gophunc/array"
+)
+
+// SplitToArray splits s by sep into a TypedArray, as strings.Split does.
+func SplitToArray(s string, sep string) *A.TypedArray[string, any] {
+	return A.New(strings.Split(s, sep)...)
+}
+
+// Lines splits s into lines. Both "\n" and "\r\n" end a line,
+// and a trailing line ending does not produce an empty last line.
+//
+// Example:
+//
+//	text.Lines("a\r\nb\n") // a, b
+func Lines(s string) *A.TypedArray[string, any] {
+	if s == "" {
+		return A.New[string]()
+	}
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return A.New(lines...)
+}
+
+// Fields splits s around runs of white space, as strings.Fields does.
+func Fields(s string) *A.TypedArray[string, any] {
+	return A.New(strings.Fields(s)...)
+}
+
+// TrimAll trims the leading and trailing white space of every string.
+func TrimAll[U any](a *A.TypedArray[string, U]) *A.TypedArray[string, any] {
+	return A.WithType[string](A.New(a.ToArray()...)).SimpleMap(strings.TrimSpace)
+}
+
+// ToLowerAll converts every string to lower case.
+func ToLowerAll[U any](a *A.TypedArray[string, U]) *A.TypedArray[string, any] {
+	return A.WithType[string](A.New(a.ToArray()...)).SimpleMap(strings.ToLower)
+}
+
+// ToUpperAll converts every string to upper case.
+func ToUpperAll[U any](a *A.TypedArray[string, U]) *A.TypedArray[string, any] {
+	return A.WithType[string](A.New(a.ToArray()...)).SimpleMap(strings.ToUpper)
+}
+
+// Join concatenates the strings of an array with sep in between.
+func Join[U any](a *A.TypedArray[string, U], sep string) string {
+	return strings.Join(a.ToArray(), sep)
+}
@@ -0,0 +1,65 @@
+package tuple
+
+// Pair holds two values of possibly different types,
+// like a key-value entry of a map.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewPair creates a new Pair.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// NewTriple creates a new Triple.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns the values of a Pair.
+//
+// Example:
+//
+//	k, v := tuple.NewPair("a", 1).Unpack()
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a new Pair with the first and second values swapped.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// Unpack returns the values of a Triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// MapPair applies f to the first value and g to the second value of a Pair.
+// It is a top-level function since the types of values could change.
+func MapPair[A, B, C, D any](p Pair[A, B], f func(A) C, g func(B) D) Pair[C, D] {
+	return Pair[C, D]{First: f(p.First), Second: g(p.Second)}
+}
+
+// MapFirst applies f to the first value of a Pair.
+func MapFirst[A, B, C any](p Pair[A, B], f func(A) C) Pair[C, B] {
+	return Pair[C, B]{First: f(p.First), Second: p.Second}
+}
+
+// MapSecond applies f to the second value of a Pair.
+func MapSecond[A, B, C any](p Pair[A, B], f func(B) C) Pair[A, C] {
+	return Pair[A, C]{First: p.First, Second: f(p.Second)}
+}
+
+// MapTriple applies f, g and h to the values of a Triple respectively.
+func MapTriple[A, B, C, D, E, F any](t Triple[A, B, C], f func(A) D, g func(B) E, h func(C) F) Triple[D, E, F] {
+	return Triple[D, E, F]{First: f(t.First), Second: g(t.Second), Third: h(t.Third)}
+}
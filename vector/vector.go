@@ -0,0 +1,169 @@
+package vector
+
+import (
+	"errors"
+	"slices"
+
+	A "github.com/eicc27/Gophunc/array"
+	O "github.com/eicc27/Gophunc/optional"
+	R "github.com/eicc27/Gophunc/result"
+)
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// Vector is a persistent array implemented as a bit-partitioned trie
+// with 32 children per node.
+// Append and Set never modify a Vector. Instead, they return a new version
+// copying only the O(log32 n) nodes on the path to the changed item,
+// and sharing all the others. This makes snapshots cheap,
+// e.g. for undo histories or concurrent readers.
+//
+// Example:
+//
+//	v1 := vector.New(1, 2, 3)
+//	v2 := v1.Append(4)
+//	fmt.Println(v1.Len(), v2.Len()) // 3, 4
+type Vector[T any] struct {
+	root  *node[T]
+	shift uint
+	size  int
+}
+
+// node is a branch with children, or a leaf with values at shift 0.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+}
+
+// New creates a new Vector with some items in order.
+func New[T any](items ...T) *Vector[T] {
+	v := &Vector[T]{}
+	for _, item := range items {
+		v = v.Append(item)
+	}
+	return v
+}
+
+// FromArray creates a new Vector from the elements of a TypedArray.
+func FromArray[T, U any](a *A.TypedArray[T, U]) *Vector[T] {
+	return New(a.ToArray()...)
+}
+
+// Len returns the number of items in the Vector.
+func (v *Vector[T]) Len() int {
+	return v.size
+}
+
+// At returns the item at index i.
+// If i is out of range, it returns a nothing optional.
+func (v *Vector[T]) At(i int) *O.Optional[T] {
+	if i < 0 || i >= v.size {
+		return O.Nothing[T]()
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= bits {
+		n = n.children[(i>>level)&mask]
+	}
+	return O.Just(n.values[i&mask])
+}
+
+// Append returns a new Vector with item added at the end.
+func (v *Vector[T]) Append(item T) *Vector[T] {
+	if v.size == 0 {
+		return &Vector[T]{root: &node[T]{values: []T{item}}, size: 1}
+	}
+	// the trie is full, so a new level is added on top of it
+	if v.size == 1<<(v.shift+bits) {
+		return &Vector[T]{
+			root:  &node[T]{children: []*node[T]{v.root, newPath(v.shift, item)}},
+			shift: v.shift + bits,
+			size:  v.size + 1,
+		}
+	}
+	return &Vector[T]{
+		root:  appendAt(v.root, v.shift, v.size, item),
+		shift: v.shift,
+		size:  v.size + 1,
+	}
+}
+
+// Set returns a new Vector with the item at index i replaced.
+// It fails if i is out of range.
+func (v *Vector[T]) Set(i int, item T) *R.Result[*Vector[T]] {
+	if i < 0 || i >= v.size {
+		return R.Error[*Vector[T]](errors.New("index out of range"))
+	}
+	return R.OK(&Vector[T]{
+		root:  setAt(v.root, v.shift, i, item),
+		shift: v.shift,
+		size:  v.size,
+	})
+}
+
+// ForEach applies f to each item in order.
+func (v *Vector[T]) ForEach(f func(T, int)) *Vector[T] {
+	i := 0
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		for _, c := range n.children {
+			walk(c)
+		}
+		for _, item := range n.values {
+			f(item, i)
+			i++
+		}
+	}
+	if v.root != nil {
+		walk(v.root)
+	}
+	return v
+}
+
+// ToTypedArray copies the items into a TypedArray in order.
+func (v *Vector[T]) ToTypedArray() *A.TypedArray[T, any] {
+	items := make([]T, 0, v.size)
+	v.ForEach(func(item T, _ int) {
+		items = append(items, item)
+	})
+	return A.New(items...)
+}
+
+func newPath[T any](level uint, item T) *node[T] {
+	if level == 0 {
+		return &node[T]{values: []T{item}}
+	}
+	return &node[T]{children: []*node[T]{newPath(level-bits, item)}}
+}
+
+func appendAt[T any](n *node[T], level uint, i int, item T) *node[T] {
+	if level == 0 {
+		values := make([]T, len(n.values), len(n.values)+1)
+		copy(values, n.values)
+		return &node[T]{values: append(values, item)}
+	}
+	idx := (i >> level) & mask
+	if idx < len(n.children) {
+		children := slices.Clone(n.children)
+		children[idx] = appendAt(n.children[idx], level-bits, i, item)
+		return &node[T]{children: children}
+	}
+	children := make([]*node[T], len(n.children), len(n.children)+1)
+	copy(children, n.children)
+	return &node[T]{children: append(children, newPath(level-bits, item))}
+}
+
+func setAt[T any](n *node[T], level uint, i int, item T) *node[T] {
+	if level == 0 {
+		values := slices.Clone(n.values)
+		values[i&mask] = item
+		return &node[T]{values: values}
+	}
+	idx := (i >> level) & mask
+	children := slices.Clone(n.children)
+	children[idx] = setAt(n.children[idx], level-bits, i, item)
+	return &node[T]{children: children}
+}
@@ -0,0 +1,63 @@
+package vector
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVector(t *testing.T) {
+	// enough items to need more than two levels of the trie
+	const n = 40000
+	v := New[int]()
+	for i := 0; i < n; i++ {
+		v = v.Append(i)
+	}
+	if v.Len() != n {
+		t.Fatalf("Len = %d, want %d", v.Len(), n)
+	}
+	for _, i := range []int{0, 31, 32, 1023, 1024, n - 1} {
+		if got := v.At(i); !got.IsSet() || got.Value() != i {
+			t.Errorf("At(%d) = %v, want %d", i, got, i)
+		}
+	}
+	if v.At(-1).IsSet() || v.At(n).IsSet() {
+		t.Error("At out of range should be nothing")
+	}
+}
+
+func TestVectorPersistent(t *testing.T) {
+	v1 := New(1, 2, 3)
+	v2 := v1.Append(4)
+	v3 := v2.Set(0, 10)
+	if !v3.IsOK() {
+		t.Fatal("Set in range failed")
+	}
+	if got := v1.ToTypedArray().ToArray(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("v1 = %v, want unchanged", got)
+	}
+	if got := v2.ToTypedArray().ToArray(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("v2 = %v, want unchanged", got)
+	}
+	v3.IfOKThen(func(v *Vector[int]) {
+		if got := v.ToTypedArray().ToArray(); !slices.Equal(got, []int{10, 2, 3, 4}) {
+			t.Errorf("v3 = %v", got)
+		}
+	})
+	if v1.Set(3, 0).IsOK() {
+		t.Error("Set out of range should fail")
+	}
+}
+
+func TestVectorForEach(t *testing.T) {
+	v := New(5, 6, 7)
+	got := make([]int, 0)
+	v.ForEach(func(item int, i int) {
+		if item != i+5 {
+			t.Errorf("ForEach gave %d at %d", item, i)
+		}
+		got = append(got, item)
+	})
+	if !slices.Equal(got, []int{5, 6, 7}) {
+		t.Errorf("ForEach visited %v", got)
+	}
+}